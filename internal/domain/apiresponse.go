@@ -14,3 +14,32 @@ type MatrixProcessingResponse struct {
 	RotatedMatrix   Matrix          `json:"rotated_matrix,omitempty"`
 	QRFactorization QRFactorization `json:"qr_factorization"`
 }
+
+// MatrixPropertiesReport es la estructura de respuesta del análisis de propiedades de una matriz.
+// Los campos que solo existen para matrices cuadradas, o que no son finitos, se omiten.
+type MatrixPropertiesReport struct {
+	Rows            int             `json:"rows"`
+	Cols            int             `json:"cols"`
+	Rank            int             `json:"rank"` // Rango numérico según los valores singulares
+	Norms           MatrixNorms     `json:"norms"`
+	ConditionNumber *float64        `json:"condition_number,omitempty"` // κ₂ = σ_max / σ_min; se omite si es infinito
+	Trace           *float64        `json:"trace,omitempty"`
+	Determinant     *float64        `json:"determinant,omitempty"`     // Se omite si desborda el rango de float64
+	LogDeterminant  *LogDeterminant `json:"log_determinant,omitempty"` // Se omite si la matriz es singular
+	Inverse         Matrix          `json:"inverse,omitempty"`         // Se omite si la matriz es singular
+}
+
+// MatrixNorms agrupa las normas matriciales habituales.
+type MatrixNorms struct {
+	One       float64 `json:"one"`       // Máxima suma absoluta por columnas
+	Two       float64 `json:"two"`       // Norma espectral, el mayor valor singular
+	Inf       float64 `json:"inf"`       // Máxima suma absoluta por filas
+	Frobenius float64 `json:"frobenius"` // Raíz de la suma de los cuadrados de los elementos
+}
+
+// LogDeterminant representa el determinante como det = Sign · exp(Value), útil cuando det
+// desborda o se anula en float64.
+type LogDeterminant struct {
+	Value float64 `json:"value"` // log|det|
+	Sign  float64 `json:"sign"`  // +1 o -1
+}
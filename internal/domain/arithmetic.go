@@ -0,0 +1,26 @@
+package domain
+
+// ArithmeticOperator identifica una operación aritmética entre matrices.
+type ArithmeticOperator string
+
+const (
+	ArithmeticAdd       ArithmeticOperator = "add"       // Suma A + B + ...; todos los operandos con la misma forma
+	ArithmeticSubtract  ArithmeticOperator = "sub"       // Resta A − B − ...; todos los operandos con la misma forma
+	ArithmeticMultiply  ArithmeticOperator = "mul"       // Producto A·B·...; columnas de cada operando = filas del siguiente
+	ArithmeticHadamard  ArithmeticOperator = "hadamard"  // Producto elemento a elemento A ∘ B ∘ ...
+	ArithmeticKronecker ArithmeticOperator = "kronecker" // Producto de Kronecker A ⊗ B ⊗ ...
+	ArithmeticPower     ArithmeticOperator = "pow"       // Potencia entera A^k de un único operando cuadrado
+)
+
+// ArithmeticRequest es la estructura para la entrada del endpoint de aritmética matricial.
+type ArithmeticRequest struct {
+	Operator ArithmeticOperator `json:"operator"`
+	Operands []Matrix           `json:"operands"`        // Operandos en orden; al menos dos salvo para pow, que usa uno
+	Power    *int               `json:"power,omitempty"` // Exponente entero de pow; negativo usa la inversa
+}
+
+// ArithmeticResult representa el resultado de una operación aritmética entre matrices.
+type ArithmeticResult struct {
+	Operator ArithmeticOperator `json:"operator"`
+	Result   Matrix             `json:"result"`
+}
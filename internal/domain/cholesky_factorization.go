@@ -0,0 +1,6 @@
+package domain
+
+// CholeskyFactorization representa el resultado de la factorización de Cholesky (A = L·Lᵀ).
+type CholeskyFactorization struct {
+	L Matrix `json:"L"` // Matriz triangular inferior L con diagonal positiva
+}
@@ -0,0 +1,10 @@
+package domain
+
+// Complex representa un número complejo en JSON como {"real": a, "imag": b}.
+type Complex struct {
+	Real float64 `json:"real"`
+	Imag float64 `json:"imag"`
+}
+
+// ComplexMatrix representa una matriz rectangular de números complejos.
+type ComplexMatrix [][]Complex
@@ -0,0 +1,17 @@
+package domain
+
+// EigenRequest es la estructura para la entrada del endpoint de valores y vectores propios.
+type EigenRequest struct {
+	Matrix       Matrix `json:"matrix"`
+	LeftVectors  bool   `json:"left_vectors"`  // Calcular los vectores propios izquierdos (yᴴ·A = λ·yᴴ)
+	RightVectors bool   `json:"right_vectors"` // Calcular los vectores propios derechos (A·x = λ·x)
+}
+
+// EigenDecomposition representa los valores propios de una matriz cuadrada y, opcionalmente,
+// sus vectores propios almacenados por columnas.
+type EigenDecomposition struct {
+	Symmetric    bool          `json:"symmetric"` // Indica si se usó el algoritmo para matrices simétricas
+	Values       []Complex     `json:"values"`
+	LeftVectors  ComplexMatrix `json:"left_vectors,omitempty"`
+	RightVectors ComplexMatrix `json:"right_vectors,omitempty"`
+}
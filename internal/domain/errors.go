@@ -3,11 +3,35 @@ package domain
 import "errors"
 
 var (
-	ErrMatrixEmpty         = errors.New("la matriz de entrada está vacía")
-	ErrMatrixNotRectangular = errors.New("la matriz de entrada no es rectangular")
-	ErrInvalidCredentials  = errors.New("credenciales inválidas")
-	ErrFailedToGenerateToken = errors.New("fallo al generar el token")
-	ErrUnauthorized        = errors.New("no autorizado")
-	ErrInvalidToken        = errors.New("token inválido o expirado")
-	ErrInvalidRequestBody  = errors.New("cuerpo de solicitud inválido")
-)
\ No newline at end of file
+	ErrMatrixEmpty               = errors.New("la matriz de entrada está vacía")
+	ErrMatrixNotRectangular      = errors.New("la matriz de entrada no es rectangular")
+	ErrMatrixNotSquare           = errors.New("la matriz de entrada no es cuadrada")
+	ErrMatrixSingular            = errors.New("la matriz de entrada es singular")
+	ErrMatrixNotSymmetric        = errors.New("la matriz de entrada no es simétrica")
+	ErrMatrixNotPositiveDefinite = errors.New("la matriz de entrada no es definida positiva")
+	ErrMatrixIllConditioned      = errors.New("la matriz de coeficientes está mal condicionada")
+	ErrDimensionMismatch         = errors.New("las dimensiones de las matrices no son compatibles")
+	ErrIncompatibleRightHandSide = errors.New("la longitud de los vectores del lado derecho no coincide con las filas de la matriz")
+	ErrUnsupportedFunction       = errors.New("la función matricial solicitada no es válida")
+	ErrMatrixFunctionUndefined   = errors.New("la función matricial no está definida para la matriz de entrada")
+	ErrInvalidTolerance          = errors.New("la tolerancia debe ser un número no negativo")
+	ErrUnsupportedOperator       = errors.New("el operador aritmético solicitado no es válido")
+	ErrInvalidOperands           = errors.New("los operandos no son válidos para el operador")
+	ErrExpressionSyntax          = errors.New("la expresión no es sintácticamente válida")
+	ErrUndefinedIdentifier       = errors.New("la expresión usa una variable o función no definida")
+	ErrExpressionUndefined       = errors.New("la expresión no está definida para los valores indicados")
+	ErrInvalidPipeline           = errors.New("el pipeline de operaciones no es válido")
+	ErrUnsupportedTransform      = errors.New("la transformación solicitada no es válida")
+	ErrUnsupportedMode           = errors.New("el modo solicitado no es válido")
+	ErrUnsupportedMatrixKind     = errors.New("el tipo de matriz solicitado no es válido")
+	ErrInvalidGeneratorOptions   = errors.New("las opciones del generador de matrices no son válidas")
+	ErrInvalidLowRankOptions     = errors.New("las opciones de la aproximación de rango bajo no son válidas")
+	ErrInvalidSparseMatrix       = errors.New("la matriz dispersa no es válida")
+	ErrInvalidSolverOptions      = errors.New("las opciones del método iterativo no son válidas")
+	ErrSolverBreakdown           = errors.New("el método iterativo se interrumpió por una división entre cero")
+	ErrInvalidCredentials        = errors.New("credenciales inválidas")
+	ErrFailedToGenerateToken     = errors.New("fallo al generar el token")
+	ErrUnauthorized              = errors.New("no autorizado")
+	ErrInvalidToken              = errors.New("token inválido o expirado")
+	ErrInvalidRequestBody        = errors.New("cuerpo de solicitud inválido")
+)
@@ -0,0 +1,15 @@
+package domain
+
+// ExpressionRequest es la estructura para la entrada del endpoint de expresiones matriciales.
+type ExpressionRequest struct {
+	Expression string            `json:"expression"`          // Expresión, p. ej. "inv(A' * A) * A' * b + 2*eye(3)"
+	Variables  map[string]Matrix `json:"variables,omitempty"` // Matrices con nombre referenciadas por la expresión
+}
+
+// ExpressionResult representa el resultado de evaluar una expresión matricial.
+type ExpressionResult struct {
+	Expression string `json:"expression"`
+	Rows       int    `json:"rows"`
+	Cols       int    `json:"cols"`
+	Result     Matrix `json:"result"`
+}
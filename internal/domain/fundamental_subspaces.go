@@ -0,0 +1,12 @@
+package domain
+
+// FundamentalSubspaces contiene bases ortonormales de los cuatro subespacios fundamentales
+// de una matriz A de m×n y rango r. Cada fila de una base es uno de sus vectores.
+type FundamentalSubspaces struct {
+	Rank          int     `json:"rank"`
+	Tolerance     float64 `json:"tolerance"`       // Tolerancia relativa efectiva: σᵢ > tolerance·σ₀ cuenta para el rango
+	ColumnSpace   Matrix  `json:"column_space"`    // r vectores de longitud m que generan la imagen de A
+	LeftNullSpace Matrix  `json:"left_null_space"` // m − r vectores de longitud m con yᵀ·A = 0
+	RowSpace      Matrix  `json:"row_space"`       // r vectores de longitud n que generan la imagen de Aᵀ
+	NullSpace     Matrix  `json:"null_space"`      // n − r vectores de longitud n con A·x = 0
+}
@@ -0,0 +1,17 @@
+package domain
+
+// GeneralizedEigenRequest es la estructura para la entrada del endpoint del problema de valores
+// propios generalizado A·v = λ·B·v.
+type GeneralizedEigenRequest struct {
+	A       Matrix `json:"a"`
+	B       Matrix `json:"b"`
+	Vectors bool   `json:"vectors"` // Calcular los vectores propios
+}
+
+// GeneralizedEigenDecomposition representa los valores propios del haz (A, B) y, opcionalmente,
+// sus vectores propios almacenados por columnas.
+type GeneralizedEigenDecomposition struct {
+	SymmetricDefinite bool          `json:"symmetric_definite"` // A simétrica y B simétrica definida positiva: reducción por Cholesky de B
+	Values            []*Complex    `json:"values"`             // Un valor nulo representa un valor propio infinito (B·v = 0 con A·v ≠ 0)
+	Vectors           ComplexMatrix `json:"vectors,omitempty"`  // En el caso simétrico definido, normalizados para que Vᵀ·B·V = I
+}
@@ -0,0 +1,7 @@
+package domain
+
+// HessenbergDecomposition representa la reducción ortogonal a forma de Hessenberg superior (A = Q·H·Qᵀ).
+type HessenbergDecomposition struct {
+	H Matrix `json:"H"` // Matriz de Hessenberg superior: ceros por debajo de la primera subdiagonal
+	Q Matrix `json:"Q"` // Matriz ortogonal Q
+}
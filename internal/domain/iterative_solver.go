@@ -0,0 +1,56 @@
+package domain
+
+// IterativeMethod indica el método de Krylov con el que se resuelve un sistema lineal.
+type IterativeMethod string
+
+const (
+	IterativeMethodCG       IterativeMethod = "cg"       // Gradiente conjugado; requiere A simétrica definida positiva
+	IterativeMethodGMRES    IterativeMethod = "gmres"    // GMRES con reinicio, para A general
+	IterativeMethodBiCGSTAB IterativeMethod = "bicgstab" // Gradiente biconjugado estabilizado, para A general
+)
+
+// Preconditioner indica el precondicionador M ≈ A que se aplica en cada iteración.
+type Preconditioner string
+
+const (
+	PreconditionerNone   Preconditioner = "none"   // Sin precondicionador (M = I)
+	PreconditionerJacobi Preconditioner = "jacobi" // Diagonal de A
+	PreconditionerILU0   Preconditioner = "ilu0"   // Factorización LU incompleta sin relleno
+)
+
+// IterativeSolverOptions agrupa las opciones de los métodos iterativos.
+type IterativeSolverOptions struct {
+	Method         IterativeMethod // Un método vacío equivale a IterativeMethodGMRES
+	Preconditioner Preconditioner  // Un precondicionador vacío equivale a PreconditionerNone
+	Tolerance      float64         // Se converge cuando ‖b − A·x‖₂ ≤ Tolerance·‖b‖₂; 0 usa 1e-10
+	MaxIterations  int             // 0 usa 10·n
+	Restart        *int            // Dimensión del subespacio de GMRES antes de reiniciar, positiva y como máximo n; nil usa min(n, 30)
+	InitialGuess   []float64       // Aproximación inicial x₀; nil usa el vector nulo
+}
+
+// IterativeSolveRequest es la estructura para la entrada del endpoint de métodos iterativos.
+// Se debe indicar la matriz en formato denso (Matrix) o disperso (Sparse), no ambos.
+type IterativeSolveRequest struct {
+	Matrix Matrix        `json:"matrix,omitempty"`
+	Sparse *SparseMatrix `json:"sparse,omitempty"`
+	B      []float64     `json:"b"`
+
+	Method         string    `json:"method,omitempty"`
+	Preconditioner string    `json:"preconditioner,omitempty"`
+	Tolerance      float64   `json:"tolerance,omitempty"`
+	MaxIterations  int       `json:"max_iterations,omitempty"`
+	Restart        *int      `json:"restart,omitempty"`
+	InitialGuess   []float64 `json:"x0,omitempty"`
+}
+
+// IterativeSolution representa el resultado de un método iterativo. Si no converge en el
+// máximo de iteraciones se devuelve la última aproximación con Converged = false.
+type IterativeSolution struct {
+	Method          IterativeMethod `json:"method"`
+	Preconditioner  Preconditioner  `json:"preconditioner"`
+	X               []float64       `json:"x"`
+	Converged       bool            `json:"converged"`
+	Iterations      int             `json:"iterations"`
+	ResidualNorm    float64         `json:"residual_norm"`    // ‖b − A·x‖₂ de la solución devuelta
+	ResidualHistory []float64       `json:"residual_history"` // Norma del residuo antes de iterar y tras cada iteración
+}
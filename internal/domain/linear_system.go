@@ -0,0 +1,39 @@
+package domain
+
+// SolverMethod identifica la factorización usada para resolver un sistema lineal.
+type SolverMethod string
+
+const (
+	SolverMethodCholesky SolverMethod = "cholesky" // A cuadrada, simétrica y definida positiva
+	SolverMethodLU       SolverMethod = "lu"       // A cuadrada general
+	SolverMethodQR       SolverMethod = "qr"       // A rectangular: mínimos cuadrados o solución de norma mínima
+)
+
+// SolveRequest es la estructura para la entrada del endpoint de sistemas lineales.
+type SolveRequest struct {
+	Matrix         Matrix `json:"matrix"` // Matriz de coeficientes A de m×n
+	RightHandSides Matrix `json:"rhs"`    // Cada fila es un vector b de longitud m
+}
+
+// LinearSystemSolution representa la solución de A·x = b para cada vector del lado derecho.
+type LinearSystemSolution struct {
+	Method        SolverMethod `json:"method"`
+	X             Matrix       `json:"X"`              // La fila j es la solución para el vector b_j
+	ResidualNorms []float64    `json:"residual_norms"` // ‖A·x_j − b_j‖₂ para cada vector
+	ResidualNorm  float64      `json:"residual_norm"`  // ‖A·X − B‖_F sobre todos los vectores
+}
+
+// LeastSquaresRequest es la estructura para la entrada del endpoint de mínimos cuadrados.
+type LeastSquaresRequest struct {
+	Matrix    Matrix    `json:"matrix"`              // Matriz de diseño A de m×n
+	B         []float64 `json:"b"`                   // Vector de observaciones de longitud m
+	Tolerance float64   `json:"tolerance,omitempty"` // Tolerancia para el rango numérico (ver QROptions)
+}
+
+// LeastSquaresFit representa la solución de min‖A·x − b‖₂.
+type LeastSquaresFit struct {
+	Coefficients         []float64 `json:"coefficients"`            // Vector x; con rango deficiente, solución básica con ceros en las columnas libres
+	Residuals            []float64 `json:"residuals"`               // b − A·x
+	ResidualSumOfSquares float64   `json:"residual_sum_of_squares"` // ‖b − A·x‖₂²
+	Rank                 int       `json:"rank"`                    // Rango numérico de A
+}
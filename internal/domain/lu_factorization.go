@@ -0,0 +1,8 @@
+package domain
+
+// LUFactorization representa el resultado de la factorización LU con pivoteo parcial (A = P·L·U).
+type LUFactorization struct {
+	P Matrix `json:"P"` // Matriz de permutación P
+	L Matrix `json:"L"` // Matriz triangular inferior L con diagonal unitaria
+	U Matrix `json:"U"` // Matriz triangular superior U
+}
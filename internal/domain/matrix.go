@@ -5,5 +5,15 @@ type Matrix [][]float64
 
 // MatrixRequest es la estructura para la entrada de la matriz en los endpoints.
 type MatrixRequest struct {
-	Matrix Matrix `json:"matrix"`
-}
\ No newline at end of file
+	Matrix    Matrix           `json:"matrix"`
+	Transform MatrixTransforms `json:"transform,omitempty"` // Transformaciones de /process-matrix; por defecto rotate-cw-90
+	Mode      string           `json:"mode,omitempty"`      // Variante de la operación; su significado depende del endpoint
+	Pivoting  bool             `json:"pivoting,omitempty"`  // Pivoteo de columnas en la factorización QR
+	Tolerance float64          `json:"tolerance,omitempty"` // Tolerancia para estimar el rango numérico
+
+	Diagnostics bool `json:"diagnostics,omitempty"` // Adjuntar métricas de calidad a la factorización QR
+	Normalize   bool `json:"normalize,omitempty"`   // Normalizar signos para que la diagonal de R sea no negativa
+
+	Algorithm string `json:"algorithm,omitempty"` // Algoritmo de la factorización QR: householder, gram-schmidt o givens
+	Trace     bool   `json:"trace,omitempty"`     // Adjuntar los pasos intermedios de la factorización QR
+}
@@ -0,0 +1,25 @@
+package domain
+
+// MatrixFunctionName identifica una función matricial f(A) sobre matrices cuadradas.
+type MatrixFunctionName string
+
+const (
+	MatrixFunctionExp  MatrixFunctionName = "exp"  // Exponencial e^A, definida para toda A
+	MatrixFunctionLog  MatrixFunctionName = "log"  // Logaritmo principal; requiere que ningún valor propio esté en (−∞, 0]
+	MatrixFunctionSqrt MatrixFunctionName = "sqrt" // Raíz cuadrada principal; requiere que ningún valor propio sea real negativo
+	MatrixFunctionPow  MatrixFunctionName = "pow"  // Potencia real A^p
+)
+
+// MatrixFunctionRequest es la estructura para la entrada del endpoint de funciones matriciales.
+type MatrixFunctionRequest struct {
+	Matrix   Matrix             `json:"matrix"`
+	Function MatrixFunctionName `json:"function"`
+	Power    *float64           `json:"power,omitempty"` // Exponente p, obligatorio para la función pow
+	T        *float64           `json:"t,omitempty"`     // Escala t: se calcula f(t·A); por defecto 1, p. ej. e^{At}
+}
+
+// MatrixFunctionResult representa el resultado de evaluar una función matricial.
+type MatrixFunctionResult struct {
+	Function MatrixFunctionName `json:"function"`
+	Result   Matrix             `json:"result"`
+}
@@ -0,0 +1,55 @@
+package domain
+
+// MatrixKind identifica la familia de matrices que produce el generador.
+type MatrixKind string
+
+const (
+	MatrixKindIdentity    MatrixKind = "identity"    // I de m×n: unos en la diagonal principal
+	MatrixKindZeros       MatrixKind = "zeros"       // Todos los elementos 0
+	MatrixKindOnes        MatrixKind = "ones"        // Todos los elementos 1
+	MatrixKindDiagonal    MatrixKind = "diagonal"    // Values en la diagonal principal
+	MatrixKindHilbert     MatrixKind = "hilbert"     // hᵢⱼ = 1/(i + j + 1), muy mal condicionada
+	MatrixKindVandermonde MatrixKind = "vandermonde" // vᵢⱼ = xᵢ^j con los nodos xᵢ de Values
+	MatrixKindToeplitz    MatrixKind = "toeplitz"    // Constante en cada diagonal: primera columna Values y primera fila Row
+	MatrixKindHankel      MatrixKind = "hankel"      // Constante en cada antidiagonal: primera columna Values y última fila Row
+	MatrixKindCirculant   MatrixKind = "circulant"   // Cada columna es la anterior desplazada; primera columna Values
+	MatrixKindTridiagonal MatrixKind = "tridiagonal" // Diagonales constantes Values = [inferior, principal, superior]
+	MatrixKindUniform     MatrixKind = "uniform"     // Elementos aleatorios uniformes en [Low, High)
+	MatrixKindNormal      MatrixKind = "normal"      // Elementos aleatorios normales N(Mean, StdDev²)
+	MatrixKindOrthogonal  MatrixKind = "orthogonal"  // Aleatoria ortogonal según la medida de Haar
+)
+
+// MatrixGeneratorOptions agrupa los parámetros del generador. Cada familia usa solo los suyos
+// y los punteros nil toman el valor indicado en cada campo.
+type MatrixGeneratorOptions struct {
+	Rows   int       // Filas; en las familias definidas por Values se deducen de ellos
+	Cols   int       // Columnas; 0 usa Rows o el valor deducido de Values
+	Values []float64 // Diagonal, nodos, primera columna o diagonales, según la familia
+	Row    []float64 // toeplitz: primera fila (por defecto Values); hankel: última fila (por defecto ceros)
+	Low    float64   // uniform: extremo inferior
+	High   *float64  // uniform: extremo superior; por defecto 1
+	Mean   float64   // normal: media
+	StdDev *float64  // normal: desviación típica; por defecto 1
+	Seed   *int64    // Semilla de las familias aleatorias, con el mismo tratamiento que en RandomizedSVDOptions
+}
+
+// GenerateMatrixRequest es la estructura para la entrada del endpoint del generador.
+type GenerateMatrixRequest struct {
+	Kind   string    `json:"kind"`
+	Rows   int       `json:"rows,omitempty"`
+	Cols   int       `json:"cols,omitempty"`
+	Values []float64 `json:"values,omitempty"`
+	Row    []float64 `json:"row,omitempty"`
+	Low    float64   `json:"low,omitempty"`
+	High   *float64  `json:"high,omitempty"`
+	Mean   float64   `json:"mean,omitempty"`
+	StdDev *float64  `json:"std_dev,omitempty"`
+	Seed   *int64    `json:"seed,omitempty"`
+}
+
+// GeneratedMatrix representa una matriz producida por el generador.
+type GeneratedMatrix struct {
+	Kind   MatrixKind `json:"kind"`
+	Seed   *int64     `json:"seed,omitempty"` // Semilla utilizada, solo en las familias aleatorias
+	Matrix Matrix     `json:"matrix"`
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MatrixTransform es una simetría del rectángulo aplicada a la disposición de los elementos de
+// una matriz: un elemento del grupo diédrico de orden 8.
+type MatrixTransform string
+
+const (
+	TransformIdentity       MatrixTransform = "identity"
+	TransformRotateCW90     MatrixTransform = "rotate-cw-90"  // Giro de 90° en sentido horario
+	TransformRotateCW180    MatrixTransform = "rotate-cw-180" // Giro de 180°
+	TransformRotateCW270    MatrixTransform = "rotate-cw-270" // Giro de 270° en sentido horario
+	TransformRotateCCW90    MatrixTransform = "rotate-ccw-90"
+	TransformRotateCCW180   MatrixTransform = "rotate-ccw-180"
+	TransformRotateCCW270   MatrixTransform = "rotate-ccw-270"
+	TransformFlipHorizontal MatrixTransform = "flip-horizontal" // Invierte el orden de las columnas
+	TransformFlipVertical   MatrixTransform = "flip-vertical"   // Invierte el orden de las filas
+	TransformTranspose      MatrixTransform = "transpose"       // Refleja sobre la diagonal principal
+	TransformAntiTranspose  MatrixTransform = "anti-transpose"  // Refleja sobre la antidiagonal
+)
+
+// MatrixTransforms es una secuencia de transformaciones que se aplican en orden. En JSON admite
+// una sola transformación ("rotate-cw-90") o una lista no vacía (["transpose", "flip-vertical"]);
+// null equivale a omitir el campo.
+type MatrixTransforms []MatrixTransform
+
+// UnmarshalJSON acepta una cadena, un array de cadenas no vacío o null.
+func (t *MatrixTransforms) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = nil
+		return nil
+	}
+	var single MatrixTransform
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = MatrixTransforms{single}
+		return nil
+	}
+	var sequence []MatrixTransform
+	if err := json.Unmarshal(data, &sequence); err != nil {
+		return err
+	}
+	if len(sequence) == 0 {
+		return fmt.Errorf("%w: la lista de transformaciones está vacía", ErrUnsupportedTransform)
+	}
+	*t = sequence
+	return nil
+}
@@ -0,0 +1,44 @@
+package domain
+
+// PipelineStep describe un paso del pipeline de operaciones. Op es obligatorio; el resto de
+// campos solo se usan en las operaciones que los admiten.
+type PipelineStep struct {
+	Op string `json:"op"`
+
+	K         *int             `json:"k,omitempty"`         // rotate: número de giros de 90° en sentido horario (por defecto 1); negativo para antihorario
+	Transform MatrixTransforms `json:"transform,omitempty"` // transform: secuencia de transformaciones
+
+	Mode        string  `json:"mode,omitempty"`        // qr, svd: modo de la factorización
+	Pivoting    bool    `json:"pivoting,omitempty"`    // qr
+	Normalize   bool    `json:"normalize,omitempty"`   // qr
+	Diagnostics bool    `json:"diagnostics,omitempty"` // qr
+	Algorithm   string  `json:"algorithm,omitempty"`   // qr
+	Trace       bool    `json:"trace,omitempty"`       // qr
+	Tolerance   float64 `json:"tolerance,omitempty"`   // qr, pseudoinverse, rref, analyze
+	Vectors     bool    `json:"vectors,omitempty"`     // eigen: calcular los vectores propios derechos
+
+	Function MatrixFunctionName `json:"function,omitempty"` // function
+	Power    *float64           `json:"power,omitempty"`    // function
+	T        *float64           `json:"t,omitempty"`        // function
+
+	Take string `json:"take,omitempty"` // Factor del resultado que pasa al paso siguiente, p. ej. "R" tras qr
+}
+
+// PipelineRequest es la estructura para la entrada del endpoint de pipeline.
+type PipelineRequest struct {
+	Matrix       Matrix         `json:"matrix"`
+	Pipeline     []PipelineStep `json:"pipeline"`
+	Intermediate bool           `json:"intermediate,omitempty"` // Devolver el resultado de cada paso además del final
+}
+
+// PipelineStepResult representa el resultado de un paso del pipeline.
+type PipelineStepResult struct {
+	Op     string      `json:"op"`
+	Result interface{} `json:"result"`
+}
+
+// PipelineResult representa el resultado de ejecutar un pipeline de operaciones.
+type PipelineResult struct {
+	Result interface{}          `json:"result"`          // Resultado del último paso; si indica take, el factor elegido
+	Steps  []PipelineStepResult `json:"steps,omitempty"` // Resultados de todos los pasos, si se pidieron
+}
@@ -0,0 +1,21 @@
+package domain
+
+// PolarDecomposition representa la descomposición polar A = U·P de una matriz m×n.
+type PolarDecomposition struct {
+	U Matrix `json:"U"` // Factor de m×n con columnas (o filas, si m < n) ortonormales
+	P Matrix `json:"P"` // Factor simétrico semidefinido positivo de n×n
+}
+
+// ProcrustesRequest es la estructura para la entrada del endpoint de Procrustes ortogonal.
+// Cada fila de A y B es un punto; ambas deben tener la misma forma.
+type ProcrustesRequest struct {
+	A              Matrix `json:"a"`
+	B              Matrix `json:"b"`
+	ProperRotation bool   `json:"proper_rotation,omitempty"` // Restringir a rotaciones propias (det = +1), sin reflexiones
+}
+
+// ProcrustesSolution representa la matriz ortogonal R que minimiza ‖A·R − B‖_F.
+type ProcrustesSolution struct {
+	Rotation Matrix  `json:"rotation"` // Matriz ortogonal R de d×d, con d el número de columnas
+	Residual float64 `json:"residual"` // ‖A·R − B‖_F
+}
@@ -0,0 +1,8 @@
+package domain
+
+// Pseudoinverse representa la pseudoinversa de Moore–Penrose A⁺ calculada mediante SVD.
+type Pseudoinverse struct {
+	Pinv      Matrix  `json:"pseudoinverse"` // Matriz A⁺ de n×m
+	Rank      int     `json:"rank"`          // Número de valores singulares conservados
+	Tolerance float64 `json:"tolerance"`     // Tolerancia relativa efectiva: se descartan los σᵢ ≤ tolerance·σ₀
+}
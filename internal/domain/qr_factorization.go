@@ -1,7 +1,80 @@
 package domain
 
+// QRMode indica la forma de los factores devueltos por la factorización QR de una matriz m×n,
+// con k = min(m, n).
+type QRMode string
+
+const (
+	QRModeFull    QRMode = "full"    // Q de m×m y R de m×n
+	QRModeEconomy QRMode = "economy" // Q de m×k y R de k×n
+	QRModeROnly   QRMode = "r-only"  // Solo R de k×n
+)
+
+// QRAlgorithm indica el algoritmo con el que se calcula la factorización QR.
+type QRAlgorithm string
+
+const (
+	QRAlgorithmHouseholder QRAlgorithm = "householder"  // Reflexiones de Householder (LAPACK, vía gonum)
+	QRAlgorithmGramSchmidt QRAlgorithm = "gram-schmidt" // Gram–Schmidt modificado
+	QRAlgorithmGivens      QRAlgorithm = "givens"       // Rotaciones de Givens
+)
+
+// QROptions agrupa las opciones de la factorización QR.
+type QROptions struct {
+	Mode        QRMode  // Un modo vacío equivale a QRModeEconomy
+	Pivoting    bool    // Usar pivoteo de columnas (A·P = Q·R) y estimar el rango numérico
+	Tolerance   float64 // Tolerancia relativa a |R₀₀| para el rango; 0 usa max(m, n)·ε
+	Diagnostics bool    // Adjuntar métricas de calidad de la factorización
+	Normalize   bool    // Cambiar signos para que la diagonal de R sea no negativa
+
+	Algorithm QRAlgorithm // Un algoritmo vacío equivale a QRAlgorithmHouseholder
+	Trace     bool        // Adjuntar los pasos intermedios del algoritmo
+}
+
+// QRDiagnostics contiene métricas de calidad de una factorización QR, calculadas sobre los
+// factores reducidos Q de m×k y R de k×n.
+type QRDiagnostics struct {
+	ReconstructionResidual float64  `json:"reconstruction_residual"`      // ‖A·P − Q·R‖_F (P = I sin pivoteo)
+	OrthogonalityError     float64  `json:"orthogonality_error"`          // ‖QᵀQ − I‖_F
+	ConditionEstimate      *float64 `json:"condition_estimate,omitempty"` // Estimación de κ₁ del bloque triangular k×k de R; se omite si es singular
+}
+
 // QRFactorization representa el resultado de la factorización QR.
 type QRFactorization struct {
-	Q Matrix `json:"Q"` // Matriz ortogonal Q
-	R Matrix `json:"R"` // Matriz triangular superior R
+	Q Matrix `json:"Q,omitempty"` // Matriz ortogonal Q
+	R Matrix `json:"R"`           // Matriz triangular superior R
+
+	Permutation []int `json:"permutation,omitempty"` // Con pivoteo, la columna j de A·P es la columna Permutation[j] de A
+	Rank        *int  `json:"rank,omitempty"`        // Con pivoteo, rango numérico estimado a partir de la diagonal de R
+
+	Diagnostics *QRDiagnostics `json:"diagnostics,omitempty"`
+	Trace       []QRStep       `json:"trace,omitempty"` // Pasos del algoritmo, en orden de aplicación
+}
+
+// QRStepKind indica el tipo de paso registrado en la traza de una factorización QR.
+type QRStepKind string
+
+const (
+	QRStepReflector     QRStepKind = "reflector"     // Householder: se aplica H = I − τ·v·vᵀ
+	QRStepNormalization QRStepKind = "normalization" // Gram–Schmidt: qⱼ = vⱼ / ‖vⱼ‖
+	QRStepProjection    QRStepKind = "projection"    // Gram–Schmidt: vⱼ ← vⱼ − rᵢⱼ·qᵢ
+	QRStepRotation      QRStepKind = "rotation"      // Givens: rotación de dos filas que anula un elemento
+)
+
+// QRStep representa un paso de la factorización QR. Los índices empiezan en 0; la descripción
+// los muestra empezando en 1.
+type QRStep struct {
+	Kind        QRStepKind `json:"kind"`
+	Description string     `json:"description"`
+	Column      int        `json:"column"` // Columna que se anula (Householder, Givens) o se ortogonaliza (Gram–Schmidt)
+
+	Vector      []float64 `json:"vector,omitempty"`      // Householder: vector v del reflector; Gram–Schmidt: vector qᵢ
+	Tau         *float64  `json:"tau,omitempty"`         // Householder: escalar τ del reflector
+	Basis       *int      `json:"basis,omitempty"`       // Gram–Schmidt: índice i del vector qᵢ
+	Coefficient *float64  `json:"coefficient,omitempty"` // Gram–Schmidt: elemento rᵢⱼ de R
+	Rows        []int     `json:"rows,omitempty"`        // Givens: par de filas rotadas
+	Cosine      *float64  `json:"cosine,omitempty"`      // Givens: coseno de la rotación
+	Sine        *float64  `json:"sine,omitempty"`        // Givens: seno de la rotación
+
+	Matrix Matrix `json:"matrix"` // Matriz de trabajo tras el paso: A parcialmente reducida o, en Gram–Schmidt, las columnas vⱼ
 }
@@ -0,0 +1,36 @@
+package domain
+
+// RandomizedSVDOptions agrupa las opciones de la SVD aleatorizada. Los campos puntero se
+// sustituyen por su valor por defecto si son nil.
+type RandomizedSVDOptions struct {
+	Rank            int    // Rango objetivo k, entre 1 y min(m, n)
+	Oversampling    *int   // Columnas aleatorias adicionales p; por defecto 10
+	PowerIterations *int   // Iteraciones de potencia q; por defecto 2
+	Seed            *int64 // Semilla del generador; por defecto se elige una al azar y se devuelve
+}
+
+// RandomizedSVDRequest es la estructura para la entrada del endpoint de SVD aleatorizada.
+type RandomizedSVDRequest struct {
+	Matrix          Matrix `json:"matrix"`
+	Rank            int    `json:"rank"`
+	Oversampling    *int   `json:"oversampling,omitempty"`
+	PowerIterations *int   `json:"power_iterations,omitempty"`
+	Seed            *int64 `json:"seed,omitempty"`
+}
+
+// RandomizedSVD representa la aproximación de rango k A ≈ U·Σ·Vᵀ obtenida con un buscador
+// aleatorizado de la imagen de A.
+type RandomizedSVD struct {
+	U     Matrix    `json:"U"`     // Vectores singulares izquierdos aproximados, m×k
+	Sigma []float64 `json:"Sigma"` // k valores singulares aproximados en orden descendente
+	VT    Matrix    `json:"VT"`    // Vectores singulares derechos aproximados traspuestos, k×n
+
+	Rank            int   `json:"rank"`
+	Oversampling    int   `json:"oversampling"`
+	PowerIterations int   `json:"power_iterations"`
+	Seed            int64 `json:"seed"` // Semilla utilizada; repetirla reproduce el resultado
+
+	FrobeniusError     float64 `json:"frobenius_error"`      // ‖A − U·Σ·Vᵀ‖_F
+	RelativeError      float64 `json:"relative_error"`       // ‖A − U·Σ·Vᵀ‖_F / ‖A‖_F
+	SpectralErrorBound float64 `json:"spectral_error_bound"` // Cota probabilística de ‖A − U·Σ·Vᵀ‖₂, válida con probabilidad ≥ 1 − 10⁻¹⁰
+}
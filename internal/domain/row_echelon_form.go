@@ -0,0 +1,42 @@
+package domain
+
+// RowOperationKind indica el tipo de operación elemental de fila.
+type RowOperationKind string
+
+const (
+	RowOperationSwap        RowOperationKind = "swap"        // Rᵢ ↔ Rⱼ
+	RowOperationScale       RowOperationKind = "scale"       // Rᵢ ← c·Rᵢ
+	RowOperationReplacement RowOperationKind = "replacement" // Rᵢ ← Rᵢ + c·Rⱼ
+	RowOperationDiscard     RowOperationKind = "discard"     // Anular los restos de redondeo de una columna sin pivote
+)
+
+// Plantillas de las descripciones de las operaciones elementales de fila. Como los mensajes de
+// error, se agrupan aquí para traducirlas en un solo lugar. Los números de fila y columna se
+// muestran empezando en 1.
+const (
+	RowSwapDescription        = "R%[1]d ↔ R%[2]d: intercambiar las filas %[1]d y %[2]d"
+	RowScaleDescription       = "R%[1]d ← R%[1]d / %[2]s: convertir el pivote de la columna %[3]d en 1"
+	RowReplacementDescription = "R%[1]d ← R%[1]d %[2]s %[3]sR%[4]d: anular el elemento de la columna %[5]d"
+	RowDiscardDescription     = "C%[1]d: la columna no tiene pivote; se anulan sus elementos de las filas %[2]d a %[3]d, menores que la tolerancia"
+)
+
+// RowOperation representa una operación elemental de fila aplicada durante la eliminación de
+// Gauss–Jordan. Los índices empiezan en 0.
+type RowOperation struct {
+	Kind        RowOperationKind `json:"kind"`
+	Description string           `json:"description"`
+	Target      int              `json:"target"`           // Fila modificada; en discard, la primera de las anuladas
+	Source      *int             `json:"source,omitempty"` // Intercambio: fila con la que se intercambia; reemplazo: fila que se suma
+	Factor      *float64         `json:"factor,omitempty"` // Escalado: c en Rᵢ ← c·Rᵢ; reemplazo: c en Rᵢ ← Rᵢ + c·Rⱼ
+	Matrix      Matrix           `json:"matrix"`           // Matriz tras aplicar la operación
+}
+
+// ReducedRowEchelonForm representa la forma escalonada reducida por filas de una matriz y las
+// operaciones que la producen.
+type ReducedRowEchelonForm struct {
+	RREF         Matrix         `json:"rref"`
+	PivotColumns []int          `json:"pivot_columns"` // Columnas con pivote, empezando en 0
+	Rank         int            `json:"rank"`          // Número de pivotes
+	Tolerance    float64        `json:"tolerance"`     // Tolerancia relativa efectiva: se anulan los |aᵢⱼ| ≤ tolerance·‖A‖∞
+	Steps        []RowOperation `json:"steps"`         // Operaciones en orden de aplicación
+}
@@ -0,0 +1,8 @@
+package domain
+
+// SchurDecomposition representa la descomposición real de Schur (A = Z·T·Zᵀ).
+type SchurDecomposition struct {
+	T           Matrix    `json:"T"`           // Forma de Schur cuasi-triangular superior; cada par complejo conjugado ocupa un bloque 2×2
+	Z           Matrix    `json:"Z"`           // Vectores de Schur: matriz ortogonal Z
+	Eigenvalues []Complex `json:"eigenvalues"` // Valores propios en el orden de la diagonal de T
+}
@@ -0,0 +1,12 @@
+package domain
+
+// SparseMatrix representa una matriz dispersa en formato de coordenadas (COO): el elemento k
+// vale Values[k] y ocupa la fila RowIndices[k] y la columna ColIndices[k], empezando en 0. Los
+// elementos repetidos se suman y los no indicados valen cero.
+type SparseMatrix struct {
+	Rows       int       `json:"rows"`
+	Cols       int       `json:"cols"`
+	RowIndices []int     `json:"row_indices"`
+	ColIndices []int     `json:"col_indices"`
+	Values     []float64 `json:"values"`
+}
@@ -0,0 +1,17 @@
+package domain
+
+// SVDMode indica qué factores de la descomposición en valores singulares se calculan.
+type SVDMode string
+
+const (
+	SVDModeFull   SVDMode = "full"   // U de m×m y Vᵀ de n×n
+	SVDModeThin   SVDMode = "thin"   // U de m×k y Vᵀ de k×n, con k = min(m, n)
+	SVDModeValues SVDMode = "values" // Solo los valores singulares
+)
+
+// SVDFactorization representa el resultado de la descomposición en valores singulares (A = U·Σ·Vᵀ).
+type SVDFactorization struct {
+	U     Matrix    `json:"U,omitempty"`  // Vectores singulares izquierdos
+	Sigma []float64 `json:"Sigma"`        // Valores singulares en orden descendente
+	VT    Matrix    `json:"VT,omitempty"` // Vectores singulares derechos traspuestos
+}
@@ -20,33 +20,64 @@ func NewMatrixHandler(matrixUc usecase.MatrixUsecase) *MatrixHandler {
 		matrixUsecase: matrixUc,
 	}
 }
+
+// matrixInputErrors relaciona los errores de dominio causados por la entrada del cliente
+// con el título que se devuelve en la respuesta 400.
+var matrixInputErrors = []struct {
+	err   error
+	title string
+}{
+	{domain.ErrMatrixEmpty, "Dimensiones de matriz inválidas"},
+	{domain.ErrMatrixNotRectangular, "Dimensiones de matriz inválidas"},
+	{domain.ErrMatrixNotSquare, "Dimensiones de matriz inválidas"},
+	{domain.ErrMatrixSingular, "Matriz singular"},
+	{domain.ErrMatrixNotSymmetric, "Matriz no simétrica"},
+	{domain.ErrMatrixNotPositiveDefinite, "Matriz no definida positiva"},
+	{domain.ErrMatrixIllConditioned, "Matriz mal condicionada"},
+	{domain.ErrIncompatibleRightHandSide, "Dimensiones de matriz inválidas"},
+	{domain.ErrDimensionMismatch, "Dimensiones de matriz inválidas"},
+	{domain.ErrUnsupportedMode, "Modo no soportado"},
+	{domain.ErrUnsupportedFunction, "Función no soportada"},
+	{domain.ErrUnsupportedTransform, "Transformación no soportada"},
+	{domain.ErrUnsupportedOperator, "Operador no soportado"},
+	{domain.ErrInvalidOperands, "Operandos inválidos"},
+	{domain.ErrExpressionSyntax, "Expresión inválida"},
+	{domain.ErrUndefinedIdentifier, "Identificador no definido"},
+	{domain.ErrExpressionUndefined, "Expresión no definida"},
+	{domain.ErrInvalidPipeline, "Pipeline inválido"},
+	{domain.ErrMatrixFunctionUndefined, "Función no definida"},
+	{domain.ErrInvalidTolerance, "Tolerancia inválida"},
+	{domain.ErrInvalidSparseMatrix, "Matriz dispersa inválida"},
+	{domain.ErrInvalidSolverOptions, "Opciones del método iterativo inválidas"},
+	{domain.ErrSolverBreakdown, "Método iterativo interrumpido"},
+	{domain.ErrInvalidLowRankOptions, "Opciones de aproximación inválidas"},
+	{domain.ErrUnsupportedMatrixKind, "Tipo de matriz no soportado"},
+	{domain.ErrInvalidGeneratorOptions, "Opciones del generador inválidas"},
+}
+
 // HandleMatrixProcessing maneja las solicitudes de procesamiento de matriz.
 func (h *MatrixHandler) HandleMatrixProcessing(c *fiber.Ctx) error {
 	var req domain.MatrixRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
-			Error:   domain.ErrInvalidRequestBody.Error(),
-			Details: "Por favor, proporcione un array de arrays de números válido en formato JSON.",
-		})
+		return invalidMatrixRequestBody(c)
 	}
 
-	rotatedMatrix, qrFactorization, err := h.matrixUsecase.ProcessMatrix(req.Matrix)
+	transformedMatrix, qrFactorization, err := h.matrixUsecase.ProcessMatrix(req.Matrix, req.Transform, domain.QROptions{
+		Mode:        domain.QRMode(req.Mode),
+		Pivoting:    req.Pivoting,
+		Tolerance:   req.Tolerance,
+		Diagnostics: req.Diagnostics,
+		Normalize:   req.Normalize,
+		Algorithm:   domain.QRAlgorithm(req.Algorithm),
+		Trace:       req.Trace,
+	})
 	if err != nil {
-		if errors.Is(err, domain.ErrMatrixEmpty) || errors.Is(err, domain.ErrMatrixNotRectangular) {
-			return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
-				Error:   "Dimensiones de matriz inválidas",
-				Details: err.Error(),
-			})
-		}
-		return c.Status(fiber.StatusInternalServerError).JSON(domain.APIResponse{
-			Error:   "Error interno del servidor",
-			Details: "Fallo al procesar la matriz: " + err.Error(),
-		})
+		return matrixErrorResponse(c, err, "Fallo al procesar la matriz: ")
 	}
 
 	response := domain.MatrixProcessingResponse{
 		OriginalMatrix:  req.Matrix,
-		RotatedMatrix:   rotatedMatrix,
+		RotatedMatrix:   transformedMatrix,
 		QRFactorization: qrFactorization,
 		// Sin campo Statistics
 	}
@@ -55,4 +86,489 @@ func (h *MatrixHandler) HandleMatrixProcessing(c *fiber.Ctx) error {
 		Data:    response,
 		Message: "Matriz procesada exitosamente.",
 	})
-}
\ No newline at end of file
+}
+
+// HandleLUFactorization maneja las solicitudes de factorización LU con pivoteo parcial.
+func (h *MatrixHandler) HandleLUFactorization(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	luFactorization, err := h.matrixUsecase.FactorizeLU(req.Matrix)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al factorizar la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    luFactorization,
+		Message: "Factorización LU calculada exitosamente.",
+	})
+}
+
+// HandleCholeskyFactorization maneja las solicitudes de factorización de Cholesky.
+func (h *MatrixHandler) HandleCholeskyFactorization(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	choleskyFactorization, err := h.matrixUsecase.FactorizeCholesky(req.Matrix)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al factorizar la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    choleskyFactorization,
+		Message: "Factorización de Cholesky calculada exitosamente.",
+	})
+}
+
+// HandleSVD maneja las solicitudes de descomposición en valores singulares.
+func (h *MatrixHandler) HandleSVD(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	svdFactorization, err := h.matrixUsecase.DecomposeSVD(req.Matrix, domain.SVDMode(req.Mode))
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al descomponer la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    svdFactorization,
+		Message: "Descomposición SVD calculada exitosamente.",
+	})
+}
+
+// HandleEigenDecomposition maneja las solicitudes de valores y vectores propios.
+func (h *MatrixHandler) HandleEigenDecomposition(c *fiber.Ctx) error {
+	var req domain.EigenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	eigenDecomposition, err := h.matrixUsecase.DecomposeEigen(req.Matrix, req.LeftVectors, req.RightVectors)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al descomponer la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    eigenDecomposition,
+		Message: "Valores propios calculados exitosamente.",
+	})
+}
+
+// HandleSolve maneja las solicitudes de resolución de sistemas lineales.
+func (h *MatrixHandler) HandleSolve(c *fiber.Ctx) error {
+	var req domain.SolveRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'matrix' y 'rhs' como arrays de arrays de números en formato JSON.",
+		})
+	}
+
+	solution, err := h.matrixUsecase.Solve(req.Matrix, req.RightHandSides)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al resolver el sistema: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    solution,
+		Message: "Sistema lineal resuelto exitosamente.",
+	})
+}
+
+// HandleLeastSquares maneja las solicitudes de ajuste por mínimos cuadrados.
+func (h *MatrixHandler) HandleLeastSquares(c *fiber.Ctx) error {
+	var req domain.LeastSquaresRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'matrix' como array de arrays de números y 'b' como array de números en formato JSON.",
+		})
+	}
+
+	fit, err := h.matrixUsecase.FitLeastSquares(req.Matrix, req.B, req.Tolerance)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al ajustar por mínimos cuadrados: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    fit,
+		Message: "Ajuste por mínimos cuadrados calculado exitosamente.",
+	})
+}
+
+// HandleMatrixAnalysis maneja las solicitudes del informe de propiedades de una matriz.
+func (h *MatrixHandler) HandleMatrixAnalysis(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	report, err := h.matrixUsecase.AnalyzeMatrix(req.Matrix, req.Tolerance)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al analizar la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    report,
+		Message: "Matriz analizada exitosamente.",
+	})
+}
+
+// HandlePseudoinverse maneja las solicitudes de pseudoinversa de Moore–Penrose.
+func (h *MatrixHandler) HandlePseudoinverse(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	pseudoinverse, err := h.matrixUsecase.Pseudoinverse(req.Matrix, req.Tolerance)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al calcular la pseudoinversa: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    pseudoinverse,
+		Message: "Pseudoinversa calculada exitosamente.",
+	})
+}
+
+// HandleFundamentalSubspaces maneja las solicitudes de bases de los subespacios fundamentales.
+func (h *MatrixHandler) HandleFundamentalSubspaces(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	subspaces, err := h.matrixUsecase.FundamentalSubspaces(req.Matrix, req.Tolerance)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al calcular los subespacios: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    subspaces,
+		Message: "Subespacios fundamentales calculados exitosamente.",
+	})
+}
+
+// HandleHessenbergReduction maneja las solicitudes de reducción a forma de Hessenberg.
+func (h *MatrixHandler) HandleHessenbergReduction(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	hessenbergDecomposition, err := h.matrixUsecase.ReduceHessenberg(req.Matrix)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al reducir la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    hessenbergDecomposition,
+		Message: "Forma de Hessenberg calculada exitosamente.",
+	})
+}
+
+// HandleSchurDecomposition maneja las solicitudes de descomposición real de Schur.
+func (h *MatrixHandler) HandleSchurDecomposition(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	schurDecomposition, err := h.matrixUsecase.DecomposeSchur(req.Matrix)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al descomponer la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    schurDecomposition,
+		Message: "Descomposición de Schur calculada exitosamente.",
+	})
+}
+
+// HandleMatrixFunction maneja las solicitudes de funciones matriciales (exp, log, sqrt, pow).
+func (h *MatrixHandler) HandleMatrixFunction(c *fiber.Ctx) error {
+	var req domain.MatrixFunctionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'matrix' como array de arrays de números y 'function' (exp, log, sqrt o pow) en formato JSON.",
+		})
+	}
+
+	t := 1.0
+	if req.T != nil {
+		t = *req.T
+	}
+
+	result, err := h.matrixUsecase.EvaluateMatrixFunction(req.Matrix, req.Function, req.Power, t)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al evaluar la función matricial: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    result,
+		Message: "Función matricial calculada exitosamente.",
+	})
+}
+
+// HandlePolarDecomposition maneja las solicitudes de descomposición polar.
+func (h *MatrixHandler) HandlePolarDecomposition(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	polarDecomposition, err := h.matrixUsecase.DecomposePolar(req.Matrix)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al descomponer la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    polarDecomposition,
+		Message: "Descomposición polar calculada exitosamente.",
+	})
+}
+
+// HandleProcrustes maneja las solicitudes del problema de Procrustes ortogonal.
+func (h *MatrixHandler) HandleProcrustes(c *fiber.Ctx) error {
+	var req domain.ProcrustesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'a' y 'b' como arrays de arrays de números en formato JSON.",
+		})
+	}
+
+	solution, err := h.matrixUsecase.SolveProcrustes(req.A, req.B, req.ProperRotation)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al resolver el problema de Procrustes: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    solution,
+		Message: "Rotación de Procrustes calculada exitosamente.",
+	})
+}
+
+// HandleRowEchelonForm maneja las solicitudes de forma escalonada reducida por filas.
+func (h *MatrixHandler) HandleRowEchelonForm(c *fiber.Ctx) error {
+	var req domain.MatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidMatrixRequestBody(c)
+	}
+
+	rref, err := h.matrixUsecase.ReduceRowEchelon(req.Matrix, req.Tolerance)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al reducir la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    rref,
+		Message: "Forma escalonada reducida calculada exitosamente.",
+	})
+}
+
+// HandleIterativeSolve maneja las solicitudes de resolución de sistemas con métodos iterativos.
+func (h *MatrixHandler) HandleIterativeSolve(c *fiber.Ctx) error {
+	var req domain.IterativeSolveRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'matrix' (o 'sparse') y 'b' en formato JSON.",
+		})
+	}
+
+	solution, err := h.matrixUsecase.SolveIterative(req.Matrix, req.Sparse, req.B, domain.IterativeSolverOptions{
+		Method:         domain.IterativeMethod(req.Method),
+		Preconditioner: domain.Preconditioner(req.Preconditioner),
+		Tolerance:      req.Tolerance,
+		MaxIterations:  req.MaxIterations,
+		Restart:        req.Restart,
+		InitialGuess:   req.InitialGuess,
+	})
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al resolver el sistema lineal: ")
+	}
+
+	message := "Sistema lineal resuelto exitosamente."
+	if !solution.Converged {
+		message = "El método iterativo no convergió en el máximo de iteraciones; se devuelve la última aproximación."
+	}
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    solution,
+		Message: message,
+	})
+}
+
+// HandleRandomizedSVD maneja las solicitudes de aproximación de rango bajo mediante SVD aleatorizada.
+func (h *MatrixHandler) HandleRandomizedSVD(c *fiber.Ctx) error {
+	var req domain.RandomizedSVDRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'matrix' y 'rank' en formato JSON.",
+		})
+	}
+
+	approximation, err := h.matrixUsecase.ApproximateLowRank(req.Matrix, domain.RandomizedSVDOptions{
+		Rank:            req.Rank,
+		Oversampling:    req.Oversampling,
+		PowerIterations: req.PowerIterations,
+		Seed:            req.Seed,
+	})
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al calcular la SVD aleatorizada: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    approximation,
+		Message: "Aproximación de rango bajo calculada exitosamente.",
+	})
+}
+
+// HandleGeneralizedEigen maneja las solicitudes del problema de valores propios generalizado.
+func (h *MatrixHandler) HandleGeneralizedEigen(c *fiber.Ctx) error {
+	var req domain.GeneralizedEigenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'a' y 'b' como arrays de arrays de números en formato JSON.",
+		})
+	}
+
+	decomposition, err := h.matrixUsecase.DecomposeGeneralizedEigen(req.A, req.B, req.Vectors)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al calcular los valores propios generalizados: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    decomposition,
+		Message: "Valores propios generalizados calculados exitosamente.",
+	})
+}
+
+// HandleArithmetic maneja las solicitudes de aritmética entre matrices.
+func (h *MatrixHandler) HandleArithmetic(c *fiber.Ctx) error {
+	var req domain.ArithmeticRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'operator' y 'operands' como lista de matrices en formato JSON.",
+		})
+	}
+
+	result, err := h.matrixUsecase.ComputeArithmetic(req.Operator, req.Operands, req.Power)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al calcular la operación aritmética: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    result,
+		Message: "Operación aritmética calculada exitosamente.",
+	})
+}
+
+// HandleExpression maneja las solicitudes de evaluación de expresiones matriciales.
+func (h *MatrixHandler) HandleExpression(c *fiber.Ctx) error {
+	var req domain.ExpressionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'expression' y 'variables' como objeto de matrices con nombre en formato JSON.",
+		})
+	}
+
+	result, err := h.matrixUsecase.EvaluateExpression(req.Expression, req.Variables)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al evaluar la expresión: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    result,
+		Message: "Expresión evaluada exitosamente.",
+	})
+}
+
+// HandleGenerateMatrix maneja las solicitudes de generación de matrices estructuradas y aleatorias.
+func (h *MatrixHandler) HandleGenerateMatrix(c *fiber.Ctx) error {
+	var req domain.GenerateMatrixRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'kind' y los parámetros de la familia (p. ej. 'rows', 'values', 'seed') en formato JSON.",
+		})
+	}
+
+	generated, err := h.matrixUsecase.GenerateMatrix(domain.MatrixKind(req.Kind), domain.MatrixGeneratorOptions{
+		Rows:   req.Rows,
+		Cols:   req.Cols,
+		Values: req.Values,
+		Row:    req.Row,
+		Low:    req.Low,
+		High:   req.High,
+		Mean:   req.Mean,
+		StdDev: req.StdDev,
+		Seed:   req.Seed,
+	})
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al generar la matriz: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    generated,
+		Message: "Matriz generada exitosamente.",
+	})
+}
+
+// HandlePipeline maneja las solicitudes de ejecución de un pipeline de operaciones.
+func (h *MatrixHandler) HandlePipeline(c *fiber.Ctx) error {
+	var req domain.PipelineRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+			Error:   domain.ErrInvalidRequestBody.Error(),
+			Details: "Por favor, proporcione 'matrix' y 'pipeline' como lista de pasos {\"op\": ...} en formato JSON.",
+		})
+	}
+
+	result, err := h.matrixUsecase.RunPipeline(req.Matrix, req.Pipeline, req.Intermediate)
+	if err != nil {
+		return matrixErrorResponse(c, err, "Fallo al ejecutar el pipeline: ")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(domain.APIResponse{
+		Data:    result,
+		Message: "Pipeline ejecutado exitosamente.",
+	})
+}
+
+// invalidMatrixRequestBody responde con 400 cuando el cuerpo no es una matriz JSON válida.
+func invalidMatrixRequestBody(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+		Error:   domain.ErrInvalidRequestBody.Error(),
+		Details: "Por favor, proporcione un array de arrays de números válido en formato JSON.",
+	})
+}
+
+// matrixErrorResponse traduce un error del caso de uso a una respuesta HTTP: 400 para los
+// errores de entrada conocidos y 500 para el resto.
+func matrixErrorResponse(c *fiber.Ctx, err error, failurePrefix string) error {
+	for _, inputErr := range matrixInputErrors {
+		if errors.Is(err, inputErr.err) {
+			return c.Status(fiber.StatusBadRequest).JSON(domain.APIResponse{
+				Error:   inputErr.title,
+				Details: err.Error(),
+			})
+		}
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(domain.APIResponse{
+		Error:   "Error interno del servidor",
+		Details: failurePrefix + err.Error(),
+	})
+}
@@ -46,6 +46,28 @@ func (r *Router) setupRoutes() {
 
 	// Las rutas protegidas usan el middleware de autenticación del authHandler.
 	api.Post("/process-matrix", r.authHandler.AuthMiddleware, r.matrixHandler.HandleMatrixProcessing)
+	api.Post("/lu", r.authHandler.AuthMiddleware, r.matrixHandler.HandleLUFactorization)
+	api.Post("/cholesky", r.authHandler.AuthMiddleware, r.matrixHandler.HandleCholeskyFactorization)
+	api.Post("/svd", r.authHandler.AuthMiddleware, r.matrixHandler.HandleSVD)
+	api.Post("/eigen", r.authHandler.AuthMiddleware, r.matrixHandler.HandleEigenDecomposition)
+	api.Post("/solve", r.authHandler.AuthMiddleware, r.matrixHandler.HandleSolve)
+	api.Post("/least-squares", r.authHandler.AuthMiddleware, r.matrixHandler.HandleLeastSquares)
+	api.Post("/analyze", r.authHandler.AuthMiddleware, r.matrixHandler.HandleMatrixAnalysis)
+	api.Post("/hessenberg", r.authHandler.AuthMiddleware, r.matrixHandler.HandleHessenbergReduction)
+	api.Post("/schur", r.authHandler.AuthMiddleware, r.matrixHandler.HandleSchurDecomposition)
+	api.Post("/pseudoinverse", r.authHandler.AuthMiddleware, r.matrixHandler.HandlePseudoinverse)
+	api.Post("/subspaces", r.authHandler.AuthMiddleware, r.matrixHandler.HandleFundamentalSubspaces)
+	api.Post("/matrix-function", r.authHandler.AuthMiddleware, r.matrixHandler.HandleMatrixFunction)
+	api.Post("/polar", r.authHandler.AuthMiddleware, r.matrixHandler.HandlePolarDecomposition)
+	api.Post("/procrustes", r.authHandler.AuthMiddleware, r.matrixHandler.HandleProcrustes)
+	api.Post("/rref", r.authHandler.AuthMiddleware, r.matrixHandler.HandleRowEchelonForm)
+	api.Post("/iterative-solve", r.authHandler.AuthMiddleware, r.matrixHandler.HandleIterativeSolve)
+	api.Post("/randomized-svd", r.authHandler.AuthMiddleware, r.matrixHandler.HandleRandomizedSVD)
+	api.Post("/generalized-eigen", r.authHandler.AuthMiddleware, r.matrixHandler.HandleGeneralizedEigen)
+	api.Post("/arithmetic", r.authHandler.AuthMiddleware, r.matrixHandler.HandleArithmetic)
+	api.Post("/expression", r.authHandler.AuthMiddleware, r.matrixHandler.HandleExpression)
+	api.Post("/generate", r.authHandler.AuthMiddleware, r.matrixHandler.HandleGenerateMatrix)
+	api.Post("/pipeline", r.authHandler.AuthMiddleware, r.matrixHandler.HandlePipeline)
 
 	r.app.Use(func(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusNotFound).JSON(domain.APIResponse{
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// maxResultElements limita el número de elementos de los resultados cuyo tamaño puede crecer
+// mucho más que el de los operandos, como el producto de Kronecker o las matrices generadas.
+const maxResultElements = 1 << 20
+
+// ComputeArithmetic valida los operandos y aplica el operador de izquierda a derecha:
+// ((A op B) op C) .... pow recibe un único operando cuadrado y el exponente power.
+func (uc *matrixUsecase) ComputeArithmetic(operator domain.ArithmeticOperator, operands []domain.Matrix, power *int) (domain.ArithmeticResult, error) {
+	for i, operand := range operands {
+		if err := validateMatrix(operand); err != nil {
+			return domain.ArithmeticResult{}, fmt.Errorf("operando %d: %w", i+1, err)
+		}
+	}
+
+	result, err := uc.computeArithmetic(operator, operands, power)
+	if err != nil {
+		return domain.ArithmeticResult{}, fmt.Errorf("error al calcular la operación aritmética: %w", err)
+	}
+	return domain.ArithmeticResult{Operator: operator, Result: fromDense(result)}, nil
+}
+
+func (uc *matrixUsecase) computeArithmetic(operator domain.ArithmeticOperator, operands []domain.Matrix, power *int) (*mat.Dense, error) {
+	if operator == domain.ArithmeticPower {
+		if len(operands) != 1 || power == nil {
+			return nil, fmt.Errorf("%w: pow requiere un único operando y el exponente 'power'", domain.ErrInvalidOperands)
+		}
+		if len(operands[0]) != len(operands[0][0]) {
+			return nil, domain.ErrMatrixNotSquare
+		}
+		if *power > math.MaxInt32 || *power < -math.MaxInt32 {
+			return nil, fmt.Errorf("%w: el valor absoluto de power no puede superar %d", domain.ErrInvalidOperands, math.MaxInt32)
+		}
+		return matrixIntegerPower(toDense(operands[0]), *power)
+	}
+
+	switch operator {
+	case domain.ArithmeticAdd, domain.ArithmeticSubtract, domain.ArithmeticMultiply, domain.ArithmeticHadamard, domain.ArithmeticKronecker:
+	default:
+		return nil, fmt.Errorf("%w: %q (use add, sub, mul, hadamard, kronecker o pow)", domain.ErrUnsupportedOperator, operator)
+	}
+	if len(operands) < 2 {
+		return nil, fmt.Errorf("%w: %s requiere al menos dos operandos", domain.ErrInvalidOperands, operator)
+	}
+
+	result := toDense(operands[0])
+	for i, operand := range operands[1:] {
+		next, err := binaryArithmetic(operator, result, toDense(operand))
+		if err != nil {
+			return nil, fmt.Errorf("operando %d: %w", i+2, err)
+		}
+		result = next
+	}
+	return result, nil
+}
+
+// binaryArithmetic aplica un operador binario a a y b, o devuelve ErrDimensionMismatch si
+// sus formas no son compatibles con él.
+func binaryArithmetic(operator domain.ArithmeticOperator, a, b *mat.Dense) (*mat.Dense, error) {
+	aRows, aCols := a.Dims()
+	bRows, bCols := b.Dims()
+	result := &mat.Dense{}
+
+	switch operator {
+	case domain.ArithmeticAdd, domain.ArithmeticSubtract, domain.ArithmeticHadamard:
+		if aRows != bRows || aCols != bCols {
+			return nil, fmt.Errorf("%w: %s requiere matrices de la misma forma (%d×%d y %d×%d)", domain.ErrDimensionMismatch, operator, aRows, aCols, bRows, bCols)
+		}
+		switch operator {
+		case domain.ArithmeticAdd:
+			result.Add(a, b)
+		case domain.ArithmeticSubtract:
+			result.Sub(a, b)
+		default:
+			result.MulElem(a, b)
+		}
+	case domain.ArithmeticMultiply:
+		if aCols != bRows {
+			return nil, fmt.Errorf("%w: mul requiere que las columnas del primer factor coincidan con las filas del segundo (%d×%d y %d×%d)", domain.ErrDimensionMismatch, aRows, aCols, bRows, bCols)
+		}
+		result.Mul(a, b)
+	case domain.ArithmeticKronecker:
+		if aRows > maxResultElements/bRows || aCols > maxResultElements/bCols || exceedsMaxResultElements(aRows*bRows, aCols*bCols) {
+			return nil, fmt.Errorf("%w: el producto de Kronecker de %d×%d y %d×%d supera los %d elementos", domain.ErrInvalidOperands, aRows, aCols, bRows, bCols, maxResultElements)
+		}
+		result.Kronecker(a, b)
+	default:
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnsupportedOperator, operator)
+	}
+	return result, nil
+}
+
+// exceedsMaxResultElements indica si una matriz de rows×cols supera maxResultElements, sin
+// desbordar la multiplicación.
+func exceedsMaxResultElements(rows, cols int) bool {
+	return rows > maxResultElements/max(cols, 1)
+}
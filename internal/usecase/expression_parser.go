@@ -0,0 +1,379 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"api-go/internal/domain"
+)
+
+// Gramática del lenguaje de expresiones, de menor a mayor precedencia:
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/" | ".*" | "./") unary }
+//	unary   = ("-" | "+") unary | power
+//	power   = postfix [ "^" unary ]
+//	postfix = primary { "'" }
+//	primary = number | name | name "(" expr { "," expr } ")" | "(" expr ")"
+//	        | "[" expr { "," expr } { ";" expr { "," expr } } "]"
+//
+// La potencia es asociativa por la derecha y tiene más precedencia que el signo: -A^2 = -(A^2).
+
+// expressionTokenKind identifica la clase de un token de la expresión.
+type expressionTokenKind int
+
+const (
+	tokenEnd expressionTokenKind = iota
+	tokenNumber
+	tokenName
+	tokenSymbol
+)
+
+// expressionToken es un token de la expresión; position es el carácter en el que empieza,
+// contando desde 1.
+type expressionToken struct {
+	kind     expressionTokenKind
+	text     string
+	value    float64
+	position int
+}
+
+// expressionSymbols son los operadores y signos de puntuación de un carácter; ".*" y "./" se
+// reconocen aparte.
+const expressionSymbols = "+-*/^'()[],;"
+
+const (
+	// maxExpressionLength es el número máximo de caracteres de una expresión.
+	maxExpressionLength = 10000
+	// maxExpressionDepth limita el anidamiento de paréntesis, corchetes, signos y exponentes,
+	// ya que el analizador desciende un nivel de recursión por cada uno.
+	maxExpressionDepth = 100
+)
+
+// tokenizeExpression divide la expresión en tokens y termina la lista con un tokenEnd.
+func tokenizeExpression(expression string) ([]expressionToken, error) {
+	runes := []rune(expression)
+	if len(runes) > maxExpressionLength {
+		return nil, expressionError(maxExpressionLength+1, domain.ErrExpressionSyntax, "la expresión supera los %d caracteres", maxExpressionLength)
+	}
+	var tokens []expressionToken
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					for i = j; i < len(runes) && unicode.IsDigit(runes[i]); i++ {
+					}
+				}
+			}
+			text := string(runes[start:i])
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, expressionError(start+1, domain.ErrExpressionSyntax, "número mal formado %q", text)
+			}
+			tokens = append(tokens, expressionToken{kind: tokenNumber, text: text, value: value, position: start + 1})
+			continue
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, expressionToken{kind: tokenName, text: string(runes[start:i]), position: start + 1})
+			continue
+		}
+
+		switch {
+		case r == '.' && i+1 < len(runes) && (runes[i+1] == '*' || runes[i+1] == '/'):
+			i += 2
+		case strings.ContainsRune(expressionSymbols, r):
+			i++
+		default:
+			return nil, expressionError(start+1, domain.ErrExpressionSyntax, "carácter inesperado %q", r)
+		}
+		tokens = append(tokens, expressionToken{kind: tokenSymbol, text: string(runes[start:i]), position: start + 1})
+	}
+	return append(tokens, expressionToken{kind: tokenEnd, position: len(runes) + 1}), nil
+}
+
+// expressionNode es un nodo del árbol sintáctico de una expresión.
+type expressionNode interface {
+	pos() int
+}
+
+type (
+	numberNode struct {
+		position int
+		value    float64
+	}
+	variableNode struct {
+		position int
+		name     string
+	}
+	negationNode struct {
+		position int
+		operand  expressionNode
+	}
+	transposeNode struct {
+		position int
+		operand  expressionNode
+	}
+	binaryNode struct {
+		position    int
+		operator    string
+		left, right expressionNode
+	}
+	callNode struct {
+		position int
+		name     string
+		args     []expressionNode
+	}
+	matrixLiteralNode struct {
+		position int
+		rows     [][]expressionNode
+	}
+)
+
+func (n *numberNode) pos() int        { return n.position }
+func (n *variableNode) pos() int      { return n.position }
+func (n *negationNode) pos() int      { return n.position }
+func (n *transposeNode) pos() int     { return n.position }
+func (n *binaryNode) pos() int        { return n.position }
+func (n *callNode) pos() int          { return n.position }
+func (n *matrixLiteralNode) pos() int { return n.position }
+
+// expressionParser es un analizador descendente recursivo sobre la lista de tokens.
+type expressionParser struct {
+	tokens []expressionToken
+	next   int
+	depth  int
+}
+
+// parseExpression analiza la expresión completa y devuelve su árbol sintáctico.
+func parseExpression(expression string) (expressionNode, error) {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &expressionParser{tokens: tokens}
+	if p.peek().kind == tokenEnd {
+		return nil, expressionError(p.peek().position, domain.ErrExpressionSyntax, "la expresión está vacía")
+	}
+
+	node, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.kind != tokenEnd {
+		return nil, expressionError(token.position, domain.ErrExpressionSyntax, "token inesperado %q", token.text)
+	}
+	return node, nil
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.next]
+}
+
+// accept consume el siguiente token si es uno de los símbolos indicados.
+func (p *expressionParser) accept(symbols ...string) (expressionToken, bool) {
+	token := p.peek()
+	if token.kind != tokenSymbol {
+		return token, false
+	}
+	for _, symbol := range symbols {
+		if token.text == symbol {
+			p.next++
+			return token, true
+		}
+	}
+	return token, false
+}
+
+// expect consume el símbolo indicado o devuelve un error de sintaxis.
+func (p *expressionParser) expect(symbol string) error {
+	if token, ok := p.accept(symbol); !ok {
+		return expressionError(token.position, domain.ErrExpressionSyntax, "se esperaba %q y se encontró %s", symbol, describeToken(token))
+	}
+	return nil
+}
+
+func (p *expressionParser) parseSum() (expressionNode, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		operator, ok := p.accept("+", "-")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{position: operator.position, operator: operator.text, left: left, right: right}
+	}
+}
+
+func (p *expressionParser) parseTerm() (expressionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		operator, ok := p.accept("*", "/", ".*", "./")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{position: operator.position, operator: operator.text, left: left, right: right}
+	}
+}
+
+// parseUnary es el punto por el que pasa toda recursión del analizador, así que es donde se
+// controla la profundidad de anidamiento.
+func (p *expressionParser) parseUnary() (expressionNode, error) {
+	if p.depth == maxExpressionDepth {
+		return nil, expressionError(p.peek().position, domain.ErrExpressionSyntax, "la expresión supera los %d niveles de anidamiento", maxExpressionDepth)
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+
+	if sign, ok := p.accept("-", "+"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if sign.text == "+" {
+			return operand, nil
+		}
+		return &negationNode{position: sign.position, operand: operand}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *expressionParser) parsePower() (expressionNode, error) {
+	base, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	operator, ok := p.accept("^")
+	if !ok {
+		return base, nil
+	}
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &binaryNode{position: operator.position, operator: "^", left: base, right: exponent}, nil
+}
+
+func (p *expressionParser) parsePostfix() (expressionNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		quote, ok := p.accept("'")
+		if !ok {
+			return node, nil
+		}
+		node = &transposeNode{position: quote.position, operand: node}
+	}
+}
+
+func (p *expressionParser) parsePrimary() (expressionNode, error) {
+	token := p.peek()
+	switch {
+	case token.kind == tokenNumber:
+		p.next++
+		return &numberNode{position: token.position, value: token.value}, nil
+
+	case token.kind == tokenName:
+		p.next++
+		if _, ok := p.accept("("); !ok {
+			return &variableNode{position: token.position, name: token.text}, nil
+		}
+		args, err := p.parseList(")")
+		if err != nil {
+			return nil, err
+		}
+		return &callNode{position: token.position, name: token.text, args: args}, nil
+
+	case token.kind == tokenSymbol && token.text == "(":
+		p.next++
+		node, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		return node, p.expect(")")
+
+	case token.kind == tokenSymbol && token.text == "[":
+		p.next++
+		literal := &matrixLiteralNode{position: token.position}
+		for {
+			row, err := p.parseList(";", "]")
+			if err != nil {
+				return nil, err
+			}
+			literal.rows = append(literal.rows, row)
+			if p.tokens[p.next-1].text == "]" {
+				return literal, nil
+			}
+		}
+	}
+	return nil, expressionError(token.position, domain.ErrExpressionSyntax, "se esperaba un número, una variable o \"(\" y se encontró %s", describeToken(token))
+}
+
+// parseList analiza expresiones separadas por comas hasta consumir uno de los terminadores.
+func (p *expressionParser) parseList(terminators ...string) ([]expressionNode, error) {
+	var items []expressionNode
+	for {
+		item, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if _, ok := p.accept(","); ok {
+			continue
+		}
+		if _, ok := p.accept(terminators...); ok {
+			return items, nil
+		}
+		expected := make([]string, len(terminators))
+		for i, terminator := range terminators {
+			expected[i] = strconv.Quote(terminator)
+		}
+		token := p.peek()
+		return nil, expressionError(token.position, domain.ErrExpressionSyntax, "se esperaba \",\" o %s y se encontró %s", strings.Join(expected, " o "), describeToken(token))
+	}
+}
+
+// describeToken describe un token para los mensajes de error.
+func describeToken(token expressionToken) string {
+	if token.kind == tokenEnd {
+		return "el final de la expresión"
+	}
+	return fmt.Sprintf("%q", token.text)
+}
+
+// expressionError localiza un error de la expresión en la posición indicada.
+func expressionError(position int, err error, format string, args ...interface{}) error {
+	return fmt.Errorf("%w en la posición %d: %s", err, position, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,453 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// EvaluateExpression valida las variables, analiza la expresión, comprueba las dimensiones de
+// todas sus operaciones y solo entonces la evalúa. Los errores de sintaxis y de dimensiones
+// indican la posición (carácter, desde 1) del operador o función que los provoca.
+func (uc *matrixUsecase) EvaluateExpression(expression string, variables map[string]domain.Matrix) (domain.ExpressionResult, error) {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := validateMatrix(variables[name]); err != nil {
+			return domain.ExpressionResult{}, fmt.Errorf("variable %q: %w", name, err)
+		}
+	}
+
+	result, err := uc.evaluateExpression(expression, variables)
+	if err != nil {
+		return domain.ExpressionResult{}, fmt.Errorf("error al evaluar la expresión: %w", err)
+	}
+	rows, cols := result.Dims()
+	return domain.ExpressionResult{Expression: expression, Rows: rows, Cols: cols, Result: fromDense(result)}, nil
+}
+
+func (uc *matrixUsecase) evaluateExpression(expression string, variables map[string]domain.Matrix) (*mat.Dense, error) {
+	root, err := parseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	e := &expressionEvaluator{uc: uc, variables: variables}
+	if _, err := e.shape(root); err != nil {
+		return nil, err
+	}
+	return e.eval(root)
+}
+
+// expressionShape son las dimensiones de una subexpresión. Las de 1×1 se tratan como escalares.
+type expressionShape struct {
+	rows, cols int
+}
+
+func (s expressionShape) scalar() bool {
+	return s.rows == 1 && s.cols == 1
+}
+
+func (s expressionShape) square() bool {
+	return s.rows == s.cols
+}
+
+func (s expressionShape) String() string {
+	return fmt.Sprintf("%d×%d", s.rows, s.cols)
+}
+
+// expressionFunction describe una función del lenguaje de expresiones: su número de
+// argumentos, la forma del resultado (que valida la de los argumentos) y su evaluación.
+type expressionFunction struct {
+	minArgs, maxArgs int
+	shape            func(call *callNode, args []expressionShape) (expressionShape, error)
+	eval             func(uc *matrixUsecase, args []*mat.Dense) (*mat.Dense, error)
+}
+
+// expressionFunctions son las funciones disponibles en las expresiones.
+var expressionFunctions = map[string]expressionFunction{
+	"inv": {1, 1, squareShape, func(_ *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		inverse := &mat.Dense{}
+		return inverse, conditionError(inverse.Inverse(args[0]))
+	}},
+	"pinv": {1, 1, transposedShape, func(uc *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		pinv, err := uc.Pseudoinverse(fromDense(args[0]), 0)
+		if err != nil {
+			return nil, err
+		}
+		return toDense(pinv.Pinv), nil
+	}},
+	"transpose": {1, 1, transposedShape, func(_ *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		return mat.DenseCopyOf(args[0].T()), nil
+	}},
+	"det": {1, 1, squareScalarShape, func(_ *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		return scalarDense(mat.Det(args[0])), nil
+	}},
+	"trace": {1, 1, squareScalarShape, func(_ *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		return scalarDense(mat.Trace(args[0])), nil
+	}},
+	"norm": {1, 1, scalarShape, func(_ *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		return scalarDense(mat.Norm(args[0], 2)), nil
+	}},
+	"rank": {1, 1, scalarShape, func(uc *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		pinv, err := uc.Pseudoinverse(fromDense(args[0]), 0)
+		if err != nil {
+			return nil, err
+		}
+		return scalarDense(float64(pinv.Rank)), nil
+	}},
+	"kron": {2, 2, func(_ *callNode, args []expressionShape) (expressionShape, error) {
+		return expressionShape{args[0].rows * args[1].rows, args[0].cols * args[1].cols}, nil
+	}, func(_ *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		return binaryArithmetic(domain.ArithmeticKronecker, args[0], args[1])
+	}},
+	"expm":  matrixFunctionExpression(domain.MatrixFunctionExp),
+	"logm":  matrixFunctionExpression(domain.MatrixFunctionLog),
+	"sqrtm": matrixFunctionExpression(domain.MatrixFunctionSqrt),
+	"eye":   constantMatrixExpression(0, true),
+	"zeros": constantMatrixExpression(0, false),
+	"ones":  constantMatrixExpression(1, false),
+}
+
+// matrixFunctionExpression expone una función matricial de EvaluateMatrixFunction.
+func matrixFunctionExpression(function domain.MatrixFunctionName) expressionFunction {
+	return expressionFunction{1, 1, squareShape, func(uc *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+		result, err := uc.evaluateMatrixFunction(fromDense(args[0]), function, nil, 1)
+		if err != nil {
+			return nil, err
+		}
+		return toDense(result.Result), nil
+	}}
+}
+
+// constantMatrixExpression crea las funciones eye, zeros y ones, que reciben las dimensiones
+// (m, o m y n) como números literales porque la forma del resultado debe conocerse antes de
+// evaluar. Si diagonal es true se ponen unos en la diagonal principal.
+func constantMatrixExpression(value float64, diagonal bool) expressionFunction {
+	return expressionFunction{
+		minArgs: 1,
+		maxArgs: 2,
+		shape: func(call *callNode, _ []expressionShape) (expressionShape, error) {
+			dims := make([]int, len(call.args))
+			for i, arg := range call.args {
+				number, ok := arg.(*numberNode)
+				if !ok || number.value < 1 || number.value != math.Trunc(number.value) || number.value > math.MaxInt32 {
+					return expressionShape{}, expressionError(arg.pos(), domain.ErrExpressionSyntax, "%s requiere dimensiones enteras positivas escritas como números", call.name)
+				}
+				dims[i] = int(number.value)
+			}
+			if len(dims) == 1 {
+				return expressionShape{dims[0], dims[0]}, nil
+			}
+			return expressionShape{dims[0], dims[1]}, nil
+		},
+		eval: func(_ *matrixUsecase, args []*mat.Dense) (*mat.Dense, error) {
+			rows := int(args[0].At(0, 0))
+			cols := rows
+			if len(args) == 2 {
+				cols = int(args[1].At(0, 0))
+			}
+			result := filledDense(rows, cols, value)
+			for i := 0; diagonal && i < rows && i < cols; i++ {
+				result.Set(i, i, 1)
+			}
+			return result, nil
+		},
+	}
+}
+
+func squareShape(call *callNode, args []expressionShape) (expressionShape, error) {
+	if !args[0].square() {
+		return expressionShape{}, expressionError(call.position, domain.ErrMatrixNotSquare, "%s requiere una matriz cuadrada y recibe una de %s", call.name, args[0])
+	}
+	return args[0], nil
+}
+
+func squareScalarShape(call *callNode, args []expressionShape) (expressionShape, error) {
+	if _, err := squareShape(call, args); err != nil {
+		return expressionShape{}, err
+	}
+	return expressionShape{1, 1}, nil
+}
+
+func scalarShape(_ *callNode, _ []expressionShape) (expressionShape, error) {
+	return expressionShape{1, 1}, nil
+}
+
+func transposedShape(_ *callNode, args []expressionShape) (expressionShape, error) {
+	return expressionShape{args[0].cols, args[0].rows}, nil
+}
+
+// expressionEvaluator comprueba y evalúa el árbol sintáctico de una expresión.
+type expressionEvaluator struct {
+	uc        *matrixUsecase
+	variables map[string]domain.Matrix
+}
+
+// shape calcula las dimensiones de node sin evaluarlo y devuelve un error de dimensiones si
+// alguna operación no es compatible con sus operandos. También rechaza los resultados de más
+// de maxResultElements elementos (p. ej. eye(100000) o kron de matrices grandes) antes de
+// reservar memoria para ellos.
+func (e *expressionEvaluator) shape(node expressionNode) (expressionShape, error) {
+	shape, err := e.shapeNode(node)
+	if err == nil && exceedsMaxResultElements(shape.rows, shape.cols) {
+		return expressionShape{}, expressionError(node.pos(), domain.ErrExpressionUndefined, "el resultado de %s supera los %d elementos", shape, maxResultElements)
+	}
+	return shape, err
+}
+
+func (e *expressionEvaluator) shapeNode(node expressionNode) (expressionShape, error) {
+	switch n := node.(type) {
+	case *numberNode:
+		return expressionShape{1, 1}, nil
+
+	case *variableNode:
+		matrix, ok := e.variables[n.name]
+		if !ok {
+			return expressionShape{}, expressionError(n.position, domain.ErrUndefinedIdentifier, "la variable %q no está definida", n.name)
+		}
+		return expressionShape{len(matrix), len(matrix[0])}, nil
+
+	case *negationNode:
+		return e.shape(n.operand)
+
+	case *transposeNode:
+		operand, err := e.shape(n.operand)
+		return expressionShape{operand.cols, operand.rows}, err
+
+	case *matrixLiteralNode:
+		cols := len(n.rows[0])
+		for _, row := range n.rows {
+			if len(row) != cols {
+				return expressionShape{}, expressionError(n.position, domain.ErrDimensionMismatch, "todas las filas de la matriz deben tener %d elementos", cols)
+			}
+			for _, element := range row {
+				shape, err := e.shape(element)
+				if err != nil {
+					return expressionShape{}, err
+				}
+				if !shape.scalar() {
+					return expressionShape{}, expressionError(element.pos(), domain.ErrDimensionMismatch, "los elementos de una matriz deben ser escalares y este es de %s", shape)
+				}
+			}
+		}
+		return expressionShape{len(n.rows), cols}, nil
+
+	case *callNode:
+		function, ok := expressionFunctions[n.name]
+		if !ok {
+			return expressionShape{}, expressionError(n.position, domain.ErrUndefinedIdentifier, "la función %q no existe", n.name)
+		}
+		if len(n.args) < function.minArgs || len(n.args) > function.maxArgs {
+			return expressionShape{}, expressionError(n.position, domain.ErrExpressionSyntax, "%s recibe %d argumentos", n.name, len(n.args))
+		}
+		args := make([]expressionShape, len(n.args))
+		for i, arg := range n.args {
+			shape, err := e.shape(arg)
+			if err != nil {
+				return expressionShape{}, err
+			}
+			args[i] = shape
+		}
+		return function.shape(n, args)
+
+	case *binaryNode:
+		left, err := e.shape(n.left)
+		if err != nil {
+			return expressionShape{}, err
+		}
+		right, err := e.shape(n.right)
+		if err != nil {
+			return expressionShape{}, err
+		}
+		return binaryShape(n, left, right)
+	}
+	return expressionShape{}, fmt.Errorf("nodo de expresión desconocido %T", node)
+}
+
+// binaryShape aplica las reglas de dimensiones de los operadores binarios. Un operando de 1×1
+// actúa como escalar en +, -, *, .* y ./.
+func binaryShape(n *binaryNode, left, right expressionShape) (expressionShape, error) {
+	switch n.operator {
+	case "+", "-", ".*", "./":
+		switch {
+		case left == right || right.scalar():
+			return left, nil
+		case left.scalar():
+			return right, nil
+		}
+	case "*":
+		switch {
+		case left.scalar():
+			return right, nil
+		case right.scalar() || left.cols == right.rows:
+			return expressionShape{left.rows, right.cols}, nil
+		}
+	case "/":
+		if right.scalar() {
+			return left, nil
+		}
+		return expressionShape{}, expressionError(n.position, domain.ErrDimensionMismatch, "el divisor debe ser escalar y es de %s", right)
+	case "^":
+		if !right.scalar() {
+			return expressionShape{}, expressionError(n.position, domain.ErrDimensionMismatch, "el exponente debe ser escalar y es de %s", right)
+		}
+		if !left.square() {
+			return expressionShape{}, expressionError(n.position, domain.ErrMatrixNotSquare, "la base de una potencia debe ser cuadrada y es de %s", left)
+		}
+		return left, nil
+	}
+	return expressionShape{}, expressionError(n.position, domain.ErrDimensionMismatch, "%q entre %s y %s", n.operator, left, right)
+}
+
+// eval evalúa node; las dimensiones ya se han comprobado con shape.
+func (e *expressionEvaluator) eval(node expressionNode) (*mat.Dense, error) {
+	result, err := e.evalNode(node)
+	if err != nil {
+		return nil, err
+	}
+	if !isFinite(result) {
+		return nil, expressionError(node.pos(), domain.ErrExpressionUndefined, "el resultado no es finito (p. ej. una división entre cero)")
+	}
+	return result, nil
+}
+
+func (e *expressionEvaluator) evalNode(node expressionNode) (*mat.Dense, error) {
+	switch n := node.(type) {
+	case *numberNode:
+		return scalarDense(n.value), nil
+
+	case *variableNode:
+		return toDense(e.variables[n.name]), nil
+
+	case *negationNode:
+		operand, err := e.eval(n.operand)
+		if err != nil {
+			return nil, err
+		}
+		operand.Scale(-1, operand)
+		return operand, nil
+
+	case *transposeNode:
+		operand, err := e.eval(n.operand)
+		if err != nil {
+			return nil, err
+		}
+		return mat.DenseCopyOf(operand.T()), nil
+
+	case *matrixLiteralNode:
+		result := mat.NewDense(len(n.rows), len(n.rows[0]), nil)
+		for r, row := range n.rows {
+			for c, element := range row {
+				value, err := e.eval(element)
+				if err != nil {
+					return nil, err
+				}
+				result.Set(r, c, value.At(0, 0))
+			}
+		}
+		return result, nil
+
+	case *callNode:
+		args := make([]*mat.Dense, len(n.args))
+		for i, arg := range n.args {
+			value, err := e.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = value
+		}
+		result, err := expressionFunctions[n.name].eval(e.uc, args)
+		if err != nil {
+			return nil, fmt.Errorf("%s en la posición %d: %w", n.name, n.position, err)
+		}
+		return result, nil
+
+	case *binaryNode:
+		left, err := e.eval(n.left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := e.eval(n.right)
+		if err != nil {
+			return nil, err
+		}
+		result, err := evalBinary(n.operator, left, right)
+		if err != nil {
+			return nil, fmt.Errorf("%q en la posición %d: %w", n.operator, n.position, err)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("nodo de expresión desconocido %T", node)
+}
+
+// evalBinary evalúa un operador binario sobre operandos de dimensiones compatibles.
+func evalBinary(operator string, left, right *mat.Dense) (*mat.Dense, error) {
+	leftRows, leftCols := left.Dims()
+	rightRows, rightCols := right.Dims()
+	leftScalar := leftRows == 1 && leftCols == 1
+	rightScalar := rightRows == 1 && rightCols == 1
+	result := &mat.Dense{}
+
+	switch operator {
+	case "+", "-", ".*", "./":
+		if leftScalar && !rightScalar {
+			left = filledDense(rightRows, rightCols, left.At(0, 0))
+		}
+		if rightScalar && !leftScalar {
+			right = filledDense(leftRows, leftCols, right.At(0, 0))
+		}
+		switch operator {
+		case "+":
+			return binaryArithmetic(domain.ArithmeticAdd, left, right)
+		case "-":
+			return binaryArithmetic(domain.ArithmeticSubtract, left, right)
+		case ".*":
+			return binaryArithmetic(domain.ArithmeticHadamard, left, right)
+		default:
+			result.DivElem(left, right)
+		}
+	case "*":
+		switch {
+		case leftScalar:
+			result.Scale(left.At(0, 0), right)
+		case rightScalar:
+			result.Scale(right.At(0, 0), left)
+		default:
+			return binaryArithmetic(domain.ArithmeticMultiply, left, right)
+		}
+	case "/":
+		result.Scale(1/right.At(0, 0), left)
+	case "^":
+		exponent := right.At(0, 0)
+		if leftScalar {
+			return scalarDense(math.Pow(left.At(0, 0), exponent)), nil
+		}
+		if exponent == math.Trunc(exponent) && math.Abs(exponent) <= math.MaxInt32 {
+			return matrixIntegerPower(left, int(exponent))
+		}
+		return matrixPow(left, exponent)
+	default:
+		return nil, fmt.Errorf("%w: operador desconocido %q", domain.ErrExpressionSyntax, operator)
+	}
+	return result, nil
+}
+
+// scalarDense devuelve value como matriz de 1×1.
+func scalarDense(value float64) *mat.Dense {
+	return mat.NewDense(1, 1, []float64{value})
+}
+
+// filledDense devuelve una matriz de rows×cols con todos los elementos iguales a value.
+func filledDense(rows, cols int, value float64) *mat.Dense {
+	data := make([]float64, rows*cols)
+	for i := range data {
+		data[i] = value
+	}
+	return mat.NewDense(rows, cols, data)
+}
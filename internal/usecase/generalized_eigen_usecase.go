@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// generalizedEigenConditionLimit es el número de condición máximo, del orden de 1/√ε, con el
+// que se acepta invertir B o A − σ·B para reducir el haz a un problema estándar.
+const generalizedEigenConditionLimit = 1e8
+
+// generalizedEigenShifts son los desplazamientos σ, relativos a ‖A‖₁/‖B‖₁, que se prueban
+// cuando B no es invertible. A − σ·B solo es singular en los valores propios del haz, así
+// que basta con que uno de ellos no sea (casi) un valor propio.
+var generalizedEigenShifts = []float64{0, 1, -1, 0.6180339887, -1.6180339887, 2.7182818285, -3.1415926536}
+
+// DecomposeGeneralizedEigen valida el haz (A, B) y resuelve A·v = λ·B·v.
+func (uc *matrixUsecase) DecomposeGeneralizedEigen(a, b domain.Matrix, vectors bool) (domain.GeneralizedEigenDecomposition, error) {
+	if err := validateSquareMatrix(a); err != nil {
+		return domain.GeneralizedEigenDecomposition{}, fmt.Errorf("matriz A: %w", err)
+	}
+	if err := validateSquareMatrix(b); err != nil {
+		return domain.GeneralizedEigenDecomposition{}, fmt.Errorf("matriz B: %w", err)
+	}
+	if len(a) != len(b) {
+		return domain.GeneralizedEigenDecomposition{}, fmt.Errorf("%w: A es %dx%d y B es %dx%d", domain.ErrDimensionMismatch, len(a), len(a), len(b), len(b))
+	}
+
+	decomposition, err := uc.decomposeGeneralizedEigen(a, b, vectors)
+	if err != nil {
+		return domain.GeneralizedEigenDecomposition{}, fmt.Errorf("error al resolver el problema de valores propios generalizado: %w", err)
+	}
+	return decomposition, nil
+}
+
+// decomposeGeneralizedEigen usa la reducción simétrica si A es simétrica y B es simétrica
+// definida positiva; en otro caso reduce el haz a un problema estándar no simétrico.
+func (uc *matrixUsecase) decomposeGeneralizedEigen(a, b domain.Matrix, vectors bool) (domain.GeneralizedEigenDecomposition, error) {
+	symA, errA := toSymDense(a)
+	symB, errB := toSymDense(b)
+	if errA == nil && errB == nil {
+		var chol mat.Cholesky
+		if ok := chol.Factorize(symB); ok {
+			return symmetricDefiniteEigen(symA, &chol, vectors)
+		}
+	}
+	return generalEigen(toDense(a), toDense(b), vectors)
+}
+
+// symmetricDefiniteEigen resuelve A·v = λ·B·v con B = L·Lᵀ: los valores propios son los de
+// la matriz simétrica C = L⁻¹·A·L⁻ᵀ y los vectores propios son v = L⁻ᵀ·w, con w los de C.
+// Los valores son reales y ascendentes, y los vectores cumplen Vᵀ·B·V = I.
+func symmetricDefiniteEigen(a *mat.SymDense, chol *mat.Cholesky, vectors bool) (domain.GeneralizedEigenDecomposition, error) {
+	var L mat.TriDense
+	chol.LTo(&L)
+	lower := L.RawTriangular()
+
+	c := mat.DenseCopyOf(a)
+	blas64.Trsm(blas.Left, blas.NoTrans, 1, lower, c.RawMatrix())
+	blas64.Trsm(blas.Right, blas.Trans, 1, lower, c.RawMatrix())
+
+	// C es simétrica salvo por errores de redondeo; se toma su parte simétrica.
+	n := a.SymmetricDim()
+	sym := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			sym.SetSym(i, j, (c.At(i, j)+c.At(j, i))/2)
+		}
+	}
+
+	var eigen mat.EigenSym
+	if ok := eigen.Factorize(sym, vectors); !ok {
+		return domain.GeneralizedEigenDecomposition{}, errors.New("el cálculo de valores propios no convergió")
+	}
+
+	result := domain.GeneralizedEigenDecomposition{SymmetricDefinite: true}
+	for _, value := range eigen.Values(nil) {
+		result.Values = append(result.Values, &domain.Complex{Real: value})
+	}
+	if vectors {
+		var V mat.Dense
+		eigen.VectorsTo(&V)
+		blas64.Trsm(blas.Left, blas.Trans, 1, lower, V.RawMatrix())
+		result.Vectors = realToComplexMatrix(&V)
+	}
+	return result, nil
+}
+
+// generalEigen reduce A·v = λ·B·v a un problema estándar con los mismos vectores propios:
+//   - B bien condicionada: B⁻¹·A·v = λ·v.
+//   - En otro caso, con σ tal que A − σ·B está bien condicionada: (A − σ·B)⁻¹·B·v = μ·v con
+//     λ = σ + 1/μ. Los μ despreciables corresponden a valores propios infinitos.
+func generalEigen(a, b *mat.Dense, vectors bool) (domain.GeneralizedEigenDecomposition, error) {
+	n, _ := a.Dims()
+
+	var lu mat.LU
+	lu.Factorize(b)
+	if !luIsSingular(&lu, b) && lu.Cond() <= generalizedEigenConditionLimit {
+		var c mat.Dense
+		if err := lu.SolveTo(&c, false, a); err != nil {
+			return domain.GeneralizedEigenDecomposition{}, conditionError(err)
+		}
+		return standardEigen(&c, vectors, func(mu complex128) *domain.Complex {
+			value := toComplex(mu)
+			return &value
+		})
+	}
+
+	scale := 1.0
+	if normB := mat.Norm(b, 1); normB > 0 {
+		scale = math.Max(mat.Norm(a, 1), 1) / normB
+	}
+	for _, shift := range generalizedEigenShifts {
+		sigma := shift * scale
+		var shifted mat.Dense
+		shifted.Scale(-sigma, b)
+		shifted.Add(a, &shifted)
+
+		lu.Factorize(&shifted)
+		if luIsSingular(&lu, &shifted) || lu.Cond() > generalizedEigenConditionLimit {
+			continue
+		}
+		var c mat.Dense
+		if err := lu.SolveTo(&c, false, b); err != nil {
+			continue
+		}
+
+		threshold := float64(n) * machineEpsilon * mat.Norm(&c, 1)
+		return standardEigen(&c, vectors, func(mu complex128) *domain.Complex {
+			if cmplx.Abs(mu) <= threshold {
+				return nil
+			}
+			value := toComplex(complex(sigma, 0) + 1/mu)
+			return &value
+		})
+	}
+	return domain.GeneralizedEigenDecomposition{}, fmt.Errorf("%w: el haz A − λ·B es singular para todo λ", domain.ErrMatrixSingular)
+}
+
+// standardEigen calcula los valores y vectores propios de c y transforma cada valor propio
+// con eigenvalue.
+func standardEigen(c *mat.Dense, vectors bool, eigenvalue func(complex128) *domain.Complex) (domain.GeneralizedEigenDecomposition, error) {
+	kind := mat.EigenNone
+	if vectors {
+		kind = mat.EigenRight
+	}
+	var eigen mat.Eigen
+	if ok := eigen.Factorize(c, kind); !ok {
+		return domain.GeneralizedEigenDecomposition{}, errors.New("el cálculo de valores propios no convergió")
+	}
+
+	result := domain.GeneralizedEigenDecomposition{}
+	for _, value := range eigen.Values(nil) {
+		result.Values = append(result.Values, eigenvalue(value))
+	}
+	if vectors {
+		var V mat.CDense
+		eigen.VectorsTo(&V)
+		result.Vectors = fromCDense(&V)
+	}
+	return result, nil
+}
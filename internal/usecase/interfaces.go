@@ -4,7 +4,29 @@ import "api-go/internal/domain"
 
 // MatrixUsecase es la interfaz para las operaciones de caso de uso de la matriz.
 type MatrixUsecase interface {
-	ProcessMatrix(originalMatrix domain.Matrix) (rotatedMatrix domain.Matrix, qrFactorization domain.QRFactorization, err error)
+	ProcessMatrix(originalMatrix domain.Matrix, transforms []domain.MatrixTransform, qrOptions domain.QROptions) (transformedMatrix domain.Matrix, qrFactorization domain.QRFactorization, err error)
+	FactorizeLU(matrix domain.Matrix) (luFactorization domain.LUFactorization, err error)
+	FactorizeCholesky(matrix domain.Matrix) (choleskyFactorization domain.CholeskyFactorization, err error)
+	DecomposeSVD(matrix domain.Matrix, mode domain.SVDMode) (svdFactorization domain.SVDFactorization, err error)
+	ApproximateLowRank(matrix domain.Matrix, options domain.RandomizedSVDOptions) (approximation domain.RandomizedSVD, err error)
+	Solve(coefficients, rightHandSides domain.Matrix) (solution domain.LinearSystemSolution, err error)
+	FitLeastSquares(matrix domain.Matrix, b []float64, tolerance float64) (fit domain.LeastSquaresFit, err error)
+	SolveIterative(matrix domain.Matrix, sparse *domain.SparseMatrix, b []float64, options domain.IterativeSolverOptions) (solution domain.IterativeSolution, err error)
+	Pseudoinverse(matrix domain.Matrix, tolerance float64) (pseudoinverse domain.Pseudoinverse, err error)
+	FundamentalSubspaces(matrix domain.Matrix, tolerance float64) (subspaces domain.FundamentalSubspaces, err error)
+	AnalyzeMatrix(matrix domain.Matrix, tolerance float64) (report domain.MatrixPropertiesReport, err error)
+	DecomposeEigen(matrix domain.Matrix, leftVectors, rightVectors bool) (eigenDecomposition domain.EigenDecomposition, err error)
+	DecomposeGeneralizedEigen(a, b domain.Matrix, vectors bool) (generalizedEigenDecomposition domain.GeneralizedEigenDecomposition, err error)
+	ReduceHessenberg(matrix domain.Matrix) (hessenbergDecomposition domain.HessenbergDecomposition, err error)
+	DecomposeSchur(matrix domain.Matrix) (schurDecomposition domain.SchurDecomposition, err error)
+	ReduceRowEchelon(matrix domain.Matrix, tolerance float64) (rref domain.ReducedRowEchelonForm, err error)
+	DecomposePolar(matrix domain.Matrix) (polarDecomposition domain.PolarDecomposition, err error)
+	SolveProcrustes(a, b domain.Matrix, properRotation bool) (solution domain.ProcrustesSolution, err error)
+	EvaluateMatrixFunction(matrix domain.Matrix, function domain.MatrixFunctionName, power *float64, t float64) (result domain.MatrixFunctionResult, err error)
+	ComputeArithmetic(operator domain.ArithmeticOperator, operands []domain.Matrix, power *int) (result domain.ArithmeticResult, err error)
+	EvaluateExpression(expression string, variables map[string]domain.Matrix) (result domain.ExpressionResult, err error)
+	GenerateMatrix(kind domain.MatrixKind, options domain.MatrixGeneratorOptions) (generated domain.GeneratedMatrix, err error)
+	RunPipeline(matrix domain.Matrix, steps []domain.PipelineStep, intermediate bool) (result domain.PipelineResult, err error)
 }
 
 // AuthUsecase es la interfaz para las operaciones de caso de uso de autenticación.
@@ -16,4 +38,4 @@ type AuthUsecase interface {
 type JWTProvider interface {
 	GenerateToken(username string, secret string) (string, error)
 	ValidateToken(tokenString string, secret string) error
-}
\ No newline at end of file
+}
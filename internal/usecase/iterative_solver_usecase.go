@@ -0,0 +1,355 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/floats"
+)
+
+const (
+	// defaultIterativeTolerance es la tolerancia relativa por defecto de los métodos iterativos.
+	defaultIterativeTolerance = 1e-10
+	// defaultGMRESRestart es la dimensión máxima por defecto del subespacio de Krylov de GMRES.
+	defaultGMRESRestart = 30
+	// maxIterationsPerUnknown y maxIterativeIterations acotan max_iterations en min(100·n, 100000):
+	// cada iteración añade una entrada al historial de la respuesta.
+	maxIterationsPerUnknown = 100
+	maxIterativeIterations  = 100000
+)
+
+// SolveIterative valida el sistema y resuelve A·x = b con un método de Krylov. A se indica en
+// formato denso (matrix) o disperso (sparse); en ambos casos se opera sobre su representación
+// CSR, de modo que el coste por iteración es proporcional al número de elementos no nulos.
+func (uc *matrixUsecase) SolveIterative(matrix domain.Matrix, sparse *domain.SparseMatrix, b []float64, options domain.IterativeSolverOptions) (domain.IterativeSolution, error) {
+	switch options.Method {
+	case "":
+		options.Method = domain.IterativeMethodGMRES
+	case domain.IterativeMethodCG, domain.IterativeMethodGMRES, domain.IterativeMethodBiCGSTAB:
+	default:
+		return domain.IterativeSolution{}, fmt.Errorf("%w: método %q (use cg, gmres o bicgstab)", domain.ErrUnsupportedMode, options.Method)
+	}
+	switch options.Preconditioner {
+	case "":
+		options.Preconditioner = domain.PreconditionerNone
+	case domain.PreconditionerNone, domain.PreconditionerJacobi, domain.PreconditionerILU0:
+	default:
+		return domain.IterativeSolution{}, fmt.Errorf("%w: precondicionador %q (use none, jacobi o ilu0)", domain.ErrUnsupportedMode, options.Preconditioner)
+	}
+	if options.Tolerance < 0 || math.IsNaN(options.Tolerance) {
+		return domain.IterativeSolution{}, domain.ErrInvalidTolerance
+	}
+	if options.MaxIterations < 0 {
+		return domain.IterativeSolution{}, fmt.Errorf("%w: max_iterations no puede ser negativo", domain.ErrInvalidSolverOptions)
+	}
+	if options.Restart != nil && *options.Restart <= 0 {
+		return domain.IterativeSolution{}, fmt.Errorf("%w: restart debe ser positivo", domain.ErrInvalidSolverOptions)
+	}
+
+	var a *csrMatrix
+	switch {
+	case sparse != nil && matrix != nil:
+		return domain.IterativeSolution{}, fmt.Errorf("%w: indique 'matrix' o 'sparse', no ambos", domain.ErrInvalidSparseMatrix)
+	case sparse != nil:
+		// Se compara con b antes de construir la CSR para no reservar memoria según un
+		// tamaño declarado por el cliente que no está respaldado por datos.
+		if sparse.Rows != len(b) {
+			return domain.IterativeSolution{}, domain.ErrIncompatibleRightHandSide
+		}
+		var err error
+		if a, err = csrFromSparse(*sparse); err != nil {
+			return domain.IterativeSolution{}, err
+		}
+	default:
+		if err := validateSquareMatrix(matrix); err != nil {
+			return domain.IterativeSolution{}, err
+		}
+		a = csrFromDense(matrix)
+	}
+
+	if len(b) != a.n {
+		return domain.IterativeSolution{}, domain.ErrIncompatibleRightHandSide
+	}
+	if limit := min(maxIterationsPerUnknown*a.n, maxIterativeIterations); options.MaxIterations > limit {
+		return domain.IterativeSolution{}, fmt.Errorf("%w: max_iterations no puede superar %d", domain.ErrInvalidSolverOptions, limit)
+	}
+	if options.InitialGuess != nil && len(options.InitialGuess) != a.n {
+		return domain.IterativeSolution{}, fmt.Errorf("%w: x0 debe tener %d elementos", domain.ErrInvalidSolverOptions, a.n)
+	}
+	if options.Method == domain.IterativeMethodCG && !a.isSymmetric() {
+		return domain.IterativeSolution{}, fmt.Errorf("%w: el gradiente conjugado requiere una matriz simétrica", domain.ErrMatrixNotSymmetric)
+	}
+
+	solution, err := uc.solveIterative(a, b, options)
+	if err != nil {
+		return domain.IterativeSolution{}, fmt.Errorf("error al resolver el sistema con %s: %w", options.Method, err)
+	}
+	return solution, nil
+}
+
+// krylovState agrupa los datos compartidos por los métodos iterativos: el residuo se compara
+// con target = tolerance·‖b‖₂ y se registra en history tras cada iteración.
+type krylovState struct {
+	a             *csrMatrix
+	b             []float64
+	m             preconditioner
+	target        float64
+	maxIterations int
+	history       []float64
+}
+
+// residual calcula r = b − A·x y devuelve ‖r‖₂.
+func (s *krylovState) residual(r, x []float64) float64 {
+	s.a.mulVec(r, x)
+	floats.SubTo(r, s.b, r)
+	return floats.Norm(r, 2)
+}
+
+// record añade una norma del residuo al historial e indica si se alcanzó la convergencia.
+func (s *krylovState) record(norm float64) bool {
+	s.history = append(s.history, norm)
+	return norm <= s.target
+}
+
+// iterations devuelve el número de iteraciones completadas.
+func (s *krylovState) iterations() int {
+	return len(s.history) - 1
+}
+
+func (uc *matrixUsecase) solveIterative(a *csrMatrix, b []float64, options domain.IterativeSolverOptions) (domain.IterativeSolution, error) {
+	tolerance := options.Tolerance
+	if tolerance == 0 {
+		tolerance = defaultIterativeTolerance
+	}
+	maxIterations := options.MaxIterations
+	if maxIterations == 0 {
+		maxIterations = 10 * a.n
+	}
+	restart := min(a.n, defaultGMRESRestart)
+	if options.Restart != nil {
+		// Con restart ≥ n GMRES converge sin reiniciar, así que un valor mayor solo reservaría
+		// una matriz de Hessenberg más grande.
+		restart = min(*options.Restart, a.n)
+	}
+
+	m, err := newPreconditioner(options.Preconditioner, a)
+	if err != nil {
+		return domain.IterativeSolution{}, err
+	}
+
+	x := make([]float64, a.n)
+	if options.InitialGuess != nil {
+		copy(x, options.InitialGuess)
+	}
+	state := &krylovState{
+		a:             a,
+		b:             b,
+		m:             m,
+		target:        tolerance * floats.Norm(b, 2),
+		maxIterations: maxIterations,
+	}
+
+	switch options.Method {
+	case domain.IterativeMethodCG:
+		err = conjugateGradient(state, x)
+	case domain.IterativeMethodBiCGSTAB:
+		err = biCGSTAB(state, x)
+	default:
+		err = gmres(state, x, restart)
+	}
+	if err != nil {
+		return domain.IterativeSolution{}, err
+	}
+
+	r := make([]float64, a.n)
+	residualNorm := state.residual(r, x)
+	return domain.IterativeSolution{
+		Method:          options.Method,
+		Preconditioner:  options.Preconditioner,
+		X:               x,
+		Converged:       residualNorm <= state.target,
+		Iterations:      state.iterations(),
+		ResidualNorm:    residualNorm,
+		ResidualHistory: state.history,
+	}, nil
+}
+
+// conjugateGradient aplica el gradiente conjugado precondicionado. Si pᵀ·A·p ≤ 0 la matriz
+// no es definida positiva y el método no es aplicable.
+func conjugateGradient(s *krylovState, x []float64) error {
+	n := s.a.n
+	r, z, p, q := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	if s.record(s.residual(r, x)) {
+		return nil
+	}
+	s.m.apply(z, r)
+	copy(p, z)
+	rz := floats.Dot(r, z)
+
+	for s.iterations() < s.maxIterations {
+		s.a.mulVec(q, p)
+		curvature := floats.Dot(p, q)
+		if curvature <= 0 {
+			return fmt.Errorf("%w: pᵀ·A·p = %g en la iteración %d", domain.ErrMatrixNotPositiveDefinite, curvature, s.iterations()+1)
+		}
+		alpha := rz / curvature
+		floats.AddScaled(x, alpha, p)
+		floats.AddScaled(r, -alpha, q)
+		if s.record(floats.Norm(r, 2)) {
+			return nil
+		}
+
+		s.m.apply(z, r)
+		nextRZ := floats.Dot(r, z)
+		beta := nextRZ / rz
+		rz = nextRZ
+		floats.AddScaledTo(p, z, beta, p)
+	}
+	return nil
+}
+
+// biCGSTAB aplica BiCGSTAB con precondicionamiento por la derecha, de modo que el residuo
+// registrado es el del sistema original.
+func biCGSTAB(s *krylovState, x []float64) error {
+	n := s.a.n
+	r := make([]float64, n)
+	if s.record(s.residual(r, x)) {
+		return nil
+	}
+	shadow := append([]float64(nil), r...)
+	p, v := make([]float64, n), make([]float64, n)
+	pHat, sVec, sHat, t := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	rho, alpha, omega := 1.0, 1.0, 1.0
+
+	for s.iterations() < s.maxIterations {
+		nextRho := floats.Dot(shadow, r)
+		if nextRho == 0 {
+			return fmt.Errorf("%w: ρ = 0 en la iteración %d", domain.ErrSolverBreakdown, s.iterations()+1)
+		}
+		if s.iterations() == 0 {
+			copy(p, r)
+		} else {
+			beta := (nextRho / rho) * (alpha / omega)
+			floats.AddScaled(p, -omega, v)
+			floats.AddScaledTo(p, r, beta, p)
+		}
+		rho = nextRho
+
+		s.m.apply(pHat, p)
+		s.a.mulVec(v, pHat)
+		denominator := floats.Dot(shadow, v)
+		if denominator == 0 {
+			return fmt.Errorf("%w: r̂ᵀ·v = 0 en la iteración %d", domain.ErrSolverBreakdown, s.iterations()+1)
+		}
+		alpha = rho / denominator
+		floats.AddScaledTo(sVec, r, -alpha, v)
+		if norm := floats.Norm(sVec, 2); norm <= s.target {
+			floats.AddScaled(x, alpha, pHat)
+			copy(r, sVec)
+			s.record(norm)
+			return nil
+		}
+
+		s.m.apply(sHat, sVec)
+		s.a.mulVec(t, sHat)
+		omega = floats.Dot(t, sVec) / floats.Dot(t, t)
+		if omega == 0 || math.IsNaN(omega) {
+			return fmt.Errorf("%w: ω = 0 en la iteración %d", domain.ErrSolverBreakdown, s.iterations()+1)
+		}
+		floats.AddScaled(x, alpha, pHat)
+		floats.AddScaled(x, omega, sHat)
+		floats.AddScaledTo(r, sVec, -omega, t)
+		if s.record(floats.Norm(r, 2)) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// gmres aplica GMRES(restart) con precondicionamiento por la derecha. En cada ciclo se
+// construye una base ortonormal del subespacio de Krylov con Arnoldi (Gram–Schmidt
+// modificado) y se triangulariza la matriz de Hessenberg con rotaciones de Givens, lo que da
+// la norma del residuo en cada iteración sin formar x. Al reiniciar se recalcula el residuo
+// verdadero.
+func gmres(s *krylovState, x []float64, restart int) error {
+	n := s.a.n
+	r, w := make([]float64, n), make([]float64, n)
+	beta := s.residual(r, x)
+	if s.record(beta) {
+		return nil
+	}
+
+	V := make([][]float64, restart+1)
+	Z := make([][]float64, restart)
+	H := make([][]float64, restart+1)
+	for i := range H {
+		H[i] = make([]float64, restart)
+	}
+	cs, sn, g := make([]float64, restart), make([]float64, restart), make([]float64, restart+1)
+
+	for s.iterations() < s.maxIterations {
+		V[0] = make([]float64, n)
+		floats.ScaleTo(V[0], 1/beta, r)
+		for i := range g {
+			g[i] = 0
+		}
+		g[0] = beta
+
+		k := 0
+		converged := false
+		for k < restart && s.iterations() < s.maxIterations {
+			j := k
+			Z[j] = make([]float64, n)
+			s.m.apply(Z[j], V[j])
+			s.a.mulVec(w, Z[j])
+			for i := 0; i <= j; i++ {
+				H[i][j] = floats.Dot(w, V[i])
+				floats.AddScaled(w, -H[i][j], V[i])
+			}
+			subdiagonal := floats.Norm(w, 2)
+			H[j+1][j] = subdiagonal
+
+			for i := 0; i < j; i++ {
+				top := cs[i]*H[i][j] + sn[i]*H[i+1][j]
+				H[i+1][j] = -sn[i]*H[i][j] + cs[i]*H[i+1][j]
+				H[i][j] = top
+			}
+			radius := math.Hypot(H[j][j], H[j+1][j])
+			if radius == 0 {
+				return fmt.Errorf("%w: la matriz de Hessenberg es singular en la iteración %d", domain.ErrSolverBreakdown, s.iterations()+1)
+			}
+			cs[j], sn[j] = H[j][j]/radius, H[j+1][j]/radius
+			H[j][j], H[j+1][j] = radius, 0
+			g[j+1] = -sn[j] * g[j]
+			g[j] = cs[j] * g[j]
+
+			k++
+			converged = s.record(math.Abs(g[j+1]))
+			if converged || subdiagonal == 0 {
+				break
+			}
+			V[j+1] = make([]float64, n)
+			floats.ScaleTo(V[j+1], 1/subdiagonal, w)
+		}
+
+		// H[:k, :k]·y = g[:k] por sustitución hacia atrás y x ← x + Z·y.
+		y := make([]float64, k)
+		for i := k - 1; i >= 0; i-- {
+			sum := g[i]
+			for l := i + 1; l < k; l++ {
+				sum -= H[i][l] * y[l]
+			}
+			y[i] = sum / H[i][i]
+		}
+		for i := 0; i < k; i++ {
+			floats.AddScaled(x, y[i], Z[i])
+		}
+
+		beta = s.residual(r, x)
+		if converged || beta <= s.target {
+			return nil
+		}
+	}
+	return nil
+}
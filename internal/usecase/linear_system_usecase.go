@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Solve valida el sistema y resuelve A·x = b para cada fila b de rightHandSides, eligiendo
+// la factorización según la forma y la estructura de A.
+func (uc *matrixUsecase) Solve(coefficients, rightHandSides domain.Matrix) (domain.LinearSystemSolution, error) {
+	if err := validateMatrix(coefficients); err != nil {
+		return domain.LinearSystemSolution{}, err
+	}
+	if err := validateMatrix(rightHandSides); err != nil {
+		return domain.LinearSystemSolution{}, fmt.Errorf("lado derecho: %w", err)
+	}
+	if len(rightHandSides[0]) != len(coefficients) {
+		return domain.LinearSystemSolution{}, domain.ErrIncompatibleRightHandSide
+	}
+
+	solution, err := uc.solve(coefficients, rightHandSides)
+	if err != nil {
+		return domain.LinearSystemSolution{}, fmt.Errorf("error al resolver el sistema lineal: %w", err)
+	}
+	return solution, nil
+}
+
+// FitLeastSquares valida el problema y resuelve min‖A·x − b‖₂ a partir de la factorización QR
+// con pivoteo de columnas de A.
+func (uc *matrixUsecase) FitLeastSquares(matrix domain.Matrix, b []float64, tolerance float64) (domain.LeastSquaresFit, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.LeastSquaresFit{}, err
+	}
+	if len(b) != len(matrix) {
+		return domain.LeastSquaresFit{}, domain.ErrIncompatibleRightHandSide
+	}
+
+	qrFactorization, err := uc.factorizeQR(matrix, domain.QROptions{Pivoting: true, Tolerance: tolerance})
+	if err != nil {
+		return domain.LeastSquaresFit{}, fmt.Errorf("error al calcular la factorización QR: %w", err)
+	}
+	return leastSquaresFromQR(matrix, b, qrFactorization), nil
+}
+
+func (uc *matrixUsecase) solve(coefficients, rightHandSides domain.Matrix) (domain.LinearSystemSolution, error) {
+	a := toDense(coefficients)
+	b := mat.DenseCopyOf(toDense(rightHandSides).T())
+
+	x, method, err := solveSystem(coefficients, b)
+	if err != nil {
+		return domain.LinearSystemSolution{}, err
+	}
+
+	var residual mat.Dense
+	residual.Mul(a, x)
+	residual.Sub(&residual, b)
+
+	_, count := b.Dims()
+	residualNorms := make([]float64, count)
+	for j := range residualNorms {
+		residualNorms[j] = mat.Norm(residual.ColView(j), 2)
+	}
+
+	return domain.LinearSystemSolution{
+		Method:        method,
+		X:             fromDense(x.T()),
+		ResidualNorms: residualNorms,
+		ResidualNorm:  mat.Norm(&residual, 2),
+	}, nil
+}
+
+// solveSystem resuelve A·X = B, donde las columnas de b son los vectores del lado derecho:
+//   - A cuadrada, simétrica y definida positiva: Cholesky.
+//   - A cuadrada general: LU con pivoteo parcial.
+//   - A de m×n con m > n: QR, solución de mínimos cuadrados.
+//   - A de m×n con m < n: QR de Aᵀ, solución de norma mínima.
+func solveSystem(coefficients domain.Matrix, b mat.Matrix) (*mat.Dense, domain.SolverMethod, error) {
+	a := toDense(coefficients)
+	rows, cols := a.Dims()
+	x := &mat.Dense{}
+
+	if rows != cols {
+		var qr mat.QR
+		var err error
+		if rows > cols {
+			qr.Factorize(a)
+			err = qr.SolveTo(x, false, b)
+		} else {
+			qr.Factorize(a.T())
+			err = qr.SolveTo(x, true, b)
+		}
+		return x, domain.SolverMethodQR, conditionError(err)
+	}
+
+	if sym, err := toSymDense(coefficients); err == nil {
+		var chol mat.Cholesky
+		if ok := chol.Factorize(sym); ok {
+			return x, domain.SolverMethodCholesky, conditionError(chol.SolveTo(x, b))
+		}
+	}
+
+	var lu mat.LU
+	lu.Factorize(a)
+	if luIsSingular(&lu, a) {
+		return nil, domain.SolverMethodLU, domain.ErrMatrixSingular
+	}
+	return x, domain.SolverMethodLU, conditionError(lu.SolveTo(x, false, b))
+}
+
+// conditionError traduce los errores mat.Condition de gonum a errores de dominio:
+// ErrMatrixSingular si la condición es infinita y ErrMatrixIllConditioned en otro caso.
+func conditionError(err error) error {
+	var condition mat.Condition
+	if !errors.As(err, &condition) {
+		return err
+	}
+	if math.IsInf(float64(condition), 1) {
+		return domain.ErrMatrixSingular
+	}
+	return fmt.Errorf("%w: número de condición estimado %.3g", domain.ErrMatrixIllConditioned, float64(condition))
+}
+
+// leastSquaresFromQR resuelve min‖A·x − b‖₂ con la factorización A·P = Q·R en modo economy.
+// Con rango r se resuelve R[:r, :r]·z = (Qᵀ·b)[:r] por sustitución hacia atrás y se deshace la
+// permutación; las columnas libres reciben coeficiente cero (solución básica).
+func leastSquaresFromQR(matrix domain.Matrix, b []float64, qr domain.QRFactorization) domain.LeastSquaresFit {
+	rank := *qr.Rank
+
+	qtb := make([]float64, rank)
+	for i := 0; i < rank; i++ {
+		for r := range qr.Q {
+			qtb[i] += qr.Q[r][i] * b[r]
+		}
+	}
+
+	z := make([]float64, rank)
+	for i := rank - 1; i >= 0; i-- {
+		sum := qtb[i]
+		for j := i + 1; j < rank; j++ {
+			sum -= qr.R[i][j] * z[j]
+		}
+		z[i] = sum / qr.R[i][i]
+	}
+
+	coefficients := make([]float64, len(matrix[0]))
+	for j := 0; j < rank; j++ {
+		coefficients[qr.Permutation[j]] = z[j]
+	}
+
+	residuals := make([]float64, len(matrix))
+	rss := 0.0
+	for r, row := range matrix {
+		residuals[r] = b[r]
+		for c, value := range row {
+			residuals[r] -= value * coefficients[c]
+		}
+		rss += residuals[r] * residuals[r]
+	}
+
+	return domain.LeastSquaresFit{
+		Coefficients:         coefficients,
+		Residuals:            residuals,
+		ResidualSumOfSquares: rss,
+		Rank:                 rank,
+	}
+}
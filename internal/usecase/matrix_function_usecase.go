@@ -0,0 +1,274 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// maxMatrixFunctionIterations limita las iteraciones de Denman–Beavers, las raíces del
+	// escalado inverso del logaritmo y los términos de su serie.
+	maxMatrixFunctionIterations = 100
+	// matrixFunctionTolerance es la variación relativa entre iteraciones a partir de la cual
+	// se considera que la iteración de Denman–Beavers ha convergido.
+	matrixFunctionTolerance = 1e-13
+)
+
+// EvaluateMatrixFunction valida la matriz y calcula f(t·A) para la función indicada. power
+// solo se usa con domain.MatrixFunctionPow, que lo requiere.
+func (uc *matrixUsecase) EvaluateMatrixFunction(matrix domain.Matrix, function domain.MatrixFunctionName, power *float64, t float64) (domain.MatrixFunctionResult, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return domain.MatrixFunctionResult{}, err
+	}
+
+	result, err := uc.evaluateMatrixFunction(matrix, function, power, t)
+	if err != nil {
+		return domain.MatrixFunctionResult{}, fmt.Errorf("error al evaluar la función matricial: %w", err)
+	}
+	return result, nil
+}
+
+func (uc *matrixUsecase) evaluateMatrixFunction(matrix domain.Matrix, function domain.MatrixFunctionName, power *float64, t float64) (domain.MatrixFunctionResult, error) {
+	a := toDense(matrix)
+	a.Scale(t, a)
+
+	var result *mat.Dense
+	var err error
+	switch function {
+	case domain.MatrixFunctionExp:
+		result = &mat.Dense{}
+		result.Exp(a)
+	case domain.MatrixFunctionLog:
+		result, err = matrixLog(a)
+	case domain.MatrixFunctionSqrt:
+		result, err = matrixSqrt(a)
+	case domain.MatrixFunctionPow:
+		if power == nil {
+			return domain.MatrixFunctionResult{}, fmt.Errorf("%w: pow requiere el exponente 'power'", domain.ErrMatrixFunctionUndefined)
+		}
+		result, err = matrixPow(a, *power)
+	default:
+		return domain.MatrixFunctionResult{}, fmt.Errorf("%w: %q (use exp, log, sqrt o pow)", domain.ErrUnsupportedFunction, function)
+	}
+	if err != nil {
+		return domain.MatrixFunctionResult{}, err
+	}
+
+	if !isFinite(result) {
+		return domain.MatrixFunctionResult{}, fmt.Errorf("%w: el resultado desborda el rango de float64", domain.ErrMatrixFunctionUndefined)
+	}
+	return domain.MatrixFunctionResult{Function: function, Result: fromDense(result)}, nil
+}
+
+// matrixLog calcula el logaritmo principal. Para matrices simétricas exige valores propios
+// positivos; en otro caso exige que ningún valor propio esté en (−∞, 0] y usa escalado
+// inverso: X = A^(1/2^k) con ‖X − I‖₁ ≤ 1/4, log(A) = 2^k·log(X) y log(X) por su serie.
+func matrixLog(a *mat.Dense) (*mat.Dense, error) {
+	if sym, err := denseToSym(a); err == nil {
+		return symmetricMatrixFunction(sym, func(lambda, _ float64) (float64, bool) {
+			return math.Log(lambda), lambda > 0
+		})
+	}
+	if err := checkSpectrumOffNegativeAxis(a); err != nil {
+		return nil, err
+	}
+
+	n, _ := a.Dims()
+	I := identity(n)
+	X := mat.DenseCopyOf(a)
+	var E mat.Dense
+	E.Sub(X, I)
+	squarings := 0
+	for mat.Norm(&E, 1) > 0.25 {
+		if squarings == maxMatrixFunctionIterations {
+			return nil, errors.New("el escalado inverso del logaritmo no convergió")
+		}
+		root, err := denmanBeaversSqrt(X)
+		if err != nil {
+			return nil, err
+		}
+		X = root
+		E.Sub(X, I)
+		squarings++
+	}
+
+	// log(I + E) = E − E²/2 + E³/3 − …, convergente porque ‖E‖₁ ≤ 1/4.
+	result := mat.NewDense(n, n, nil)
+	term := mat.DenseCopyOf(&E)
+	var scaled mat.Dense
+	for k := 1; k <= maxMatrixFunctionIterations; k++ {
+		scaled.Scale(math.Pow(-1, float64(k+1))/float64(k), term)
+		result.Add(result, &scaled)
+		if mat.Norm(&scaled, 1) <= machineEpsilon*mat.Norm(result, 1) {
+			break
+		}
+		term.Mul(term, &E)
+	}
+
+	result.Scale(math.Pow(2, float64(squarings)), result)
+	return result, nil
+}
+
+// matrixSqrt calcula la raíz cuadrada principal. Para matrices simétricas exige valores propios
+// no negativos; en otro caso exige que ningún valor propio esté en (−∞, 0] y usa la iteración
+// de Denman–Beavers.
+func matrixSqrt(a *mat.Dense) (*mat.Dense, error) {
+	if sym, err := denseToSym(a); err == nil {
+		return symmetricMatrixFunction(sym, func(lambda, tolerance float64) (float64, bool) {
+			return math.Sqrt(math.Max(lambda, 0)), lambda >= -tolerance
+		})
+	}
+	if err := checkSpectrumOffNegativeAxis(a); err != nil {
+		return nil, err
+	}
+	return denmanBeaversSqrt(a)
+}
+
+// matrixPow calcula A^p. Los exponentes enteros usan matrixIntegerPower; los no enteros usan
+// f(λ) = λ^p sobre matrices simétricas o exp(p·log(A)).
+func matrixPow(a *mat.Dense, power float64) (*mat.Dense, error) {
+	if math.IsNaN(power) || math.IsInf(power, 0) {
+		return nil, fmt.Errorf("%w: el exponente debe ser finito", domain.ErrMatrixFunctionUndefined)
+	}
+
+	if power == math.Trunc(power) && math.Abs(power) <= math.MaxInt32 {
+		result, err := matrixIntegerPower(a, int(power))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrMatrixFunctionUndefined, err)
+		}
+		return result, nil
+	}
+
+	if sym, err := denseToSym(a); err == nil {
+		return symmetricMatrixFunction(sym, func(lambda, tolerance float64) (float64, bool) {
+			if math.Abs(lambda) <= tolerance {
+				return 0, power > 0
+			}
+			return math.Pow(lambda, power), lambda > 0
+		})
+	}
+
+	logarithm, err := matrixLog(a)
+	if err != nil {
+		return nil, err
+	}
+	logarithm.Scale(power, logarithm)
+	result := &mat.Dense{}
+	result.Exp(logarithm)
+	return result, nil
+}
+
+// matrixIntegerPower calcula A^k por exponenciación binaria. A^0 = I y los exponentes
+// negativos elevan la inversa de A.
+func matrixIntegerPower(a *mat.Dense, k int) (*mat.Dense, error) {
+	base := a
+	if k < 0 {
+		base = &mat.Dense{}
+		if err := conditionError(base.Inverse(a)); err != nil {
+			return nil, fmt.Errorf("una potencia negativa requiere una matriz invertible: %w", err)
+		}
+		k = -k
+	}
+	result := &mat.Dense{}
+	result.Pow(base, k)
+	return result, nil
+}
+
+// symmetricMatrixFunction calcula f(A) = V·f(Λ)·Vᵀ a partir de la descomposición espectral
+// de A. f recibe cada valor propio y una tolerancia absoluta n·ε·‖A‖₁ para decidir si un
+// valor cercano a cero debe tratarse como cero; devuelve false si f no está definida en él.
+func symmetricMatrixFunction(sym *mat.SymDense, f func(lambda, tolerance float64) (float64, bool)) (*mat.Dense, error) {
+	var eigen mat.EigenSym
+	if ok := eigen.Factorize(sym, true); !ok {
+		return nil, errors.New("el cálculo de valores propios no convergió")
+	}
+	n := sym.SymmetricDim()
+	tolerance := float64(n) * machineEpsilon * mat.Norm(sym, 1)
+
+	values := eigen.Values(nil)
+	for i, lambda := range values {
+		value, ok := f(lambda, tolerance)
+		if !ok {
+			return nil, fmt.Errorf("%w: valor propio %g fuera del dominio", domain.ErrMatrixFunctionUndefined, lambda)
+		}
+		values[i] = value
+	}
+
+	var V mat.Dense
+	eigen.VectorsTo(&V)
+	scaled := mat.DenseCopyOf(&V)
+	for j, value := range values {
+		column := scaled.ColView(j).(*mat.VecDense)
+		column.ScaleVec(value, column)
+	}
+	result := &mat.Dense{}
+	result.Mul(scaled, V.T())
+	return result, nil
+}
+
+// checkSpectrumOffNegativeAxis devuelve ErrMatrixFunctionUndefined si algún valor propio de a
+// está, salvo tolerancia, en el semieje real (−∞, 0], donde log y la raíz principal no están
+// definidos para matrices no simétricas.
+func checkSpectrumOffNegativeAxis(a *mat.Dense) error {
+	var eigen mat.Eigen
+	if ok := eigen.Factorize(a, mat.EigenNone); !ok {
+		return errors.New("el cálculo de valores propios no convergió")
+	}
+	n, _ := a.Dims()
+	tolerance := float64(n) * machineEpsilon * mat.Norm(a, 1)
+
+	for _, lambda := range eigen.Values(nil) {
+		if math.Abs(imag(lambda)) <= tolerance && real(lambda) <= tolerance {
+			return fmt.Errorf("%w: valor propio %g en el semieje real no positivo", domain.ErrMatrixFunctionUndefined, real(lambda))
+		}
+	}
+	return nil
+}
+
+// denmanBeaversSqrt aproxima la raíz cuadrada principal con la iteración de Denman–Beavers:
+// Y₀ = A, Z₀ = I, Yₖ₊₁ = (Yₖ + Zₖ⁻¹)/2, Zₖ₊₁ = (Zₖ + Yₖ⁻¹)/2, con Yₖ → A^(1/2).
+func denmanBeaversSqrt(a *mat.Dense) (*mat.Dense, error) {
+	n, _ := a.Dims()
+	Y := mat.DenseCopyOf(a)
+	Z := identity(n)
+
+	for k := 0; k < maxMatrixFunctionIterations; k++ {
+		var yInverse, zInverse mat.Dense
+		if err := conditionError(yInverse.Inverse(Y)); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrMatrixFunctionUndefined, err)
+		}
+		if err := conditionError(zInverse.Inverse(Z)); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrMatrixFunctionUndefined, err)
+		}
+
+		var nextY, nextZ, change mat.Dense
+		nextY.Add(Y, &zInverse)
+		nextY.Scale(0.5, &nextY)
+		nextZ.Add(Z, &yInverse)
+		nextZ.Scale(0.5, &nextZ)
+
+		change.Sub(&nextY, Y)
+		Y, Z = &nextY, &nextZ
+		if mat.Norm(&change, 2) <= matrixFunctionTolerance*mat.Norm(Y, 2) {
+			return Y, nil
+		}
+	}
+	return nil, errors.New("la iteración de Denman–Beavers no convergió")
+}
+
+// isFinite indica si todos los elementos de m son finitos.
+func isFinite(m mat.Matrix) bool {
+	rows, cols := m.Dims()
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if value := m.At(r, c); math.IsNaN(value) || math.IsInf(value, 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
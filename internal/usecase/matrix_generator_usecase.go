@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// GenerateMatrix valida las opciones y construye una matriz de la familia indicada. Las
+// familias aleatorias son deterministas para una misma semilla, que se devuelve en el resultado.
+func (uc *matrixUsecase) GenerateMatrix(kind domain.MatrixKind, options domain.MatrixGeneratorOptions) (domain.GeneratedMatrix, error) {
+	matrix, seed, err := uc.generateMatrix(kind, options)
+	if err == nil && !isFinite(matrix) {
+		err = fmt.Errorf("%w: los elementos desbordan el rango de float64", domain.ErrInvalidGeneratorOptions)
+	}
+	if err != nil {
+		return domain.GeneratedMatrix{}, fmt.Errorf("error al generar la matriz: %w", err)
+	}
+	return domain.GeneratedMatrix{Kind: kind, Seed: seed, Matrix: fromDense(matrix)}, nil
+}
+
+func (uc *matrixUsecase) generateMatrix(kind domain.MatrixKind, options domain.MatrixGeneratorOptions) (*mat.Dense, *int64, error) {
+	switch kind {
+	case domain.MatrixKindIdentity, domain.MatrixKindZeros, domain.MatrixKindOnes, domain.MatrixKindHilbert:
+		rows, cols, err := generatorSize(options.Rows, options.Cols)
+		if err != nil {
+			return nil, nil, err
+		}
+		return generateFunction(rows, cols, structuredEntry(kind)), nil, nil
+
+	case domain.MatrixKindDiagonal:
+		n := len(options.Values)
+		rows := options.Rows
+		if rows == 0 {
+			rows = n
+		}
+		rows, cols, err := generatorSize(rows, options.Cols)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n == 0 || min(rows, cols) != n {
+			return nil, nil, fmt.Errorf("%w: diagonal requiere min(rows, cols) = %d valores", domain.ErrInvalidGeneratorOptions, min(rows, cols))
+		}
+		return generateFunction(rows, cols, func(i, j int) float64 {
+			if i == j {
+				return options.Values[i]
+			}
+			return 0
+		}), nil, nil
+
+	case domain.MatrixKindVandermonde:
+		cols := options.Cols
+		if cols == 0 {
+			cols = len(options.Values)
+		}
+		rows, cols, err := derivedGeneratorSize(options, len(options.Values), cols)
+		if err != nil {
+			return nil, nil, err
+		}
+		return generateFunction(rows, cols, func(i, j int) float64 {
+			return math.Pow(options.Values[i], float64(j))
+		}), nil, nil
+
+	case domain.MatrixKindToeplitz:
+		column, row := options.Values, options.Row
+		if row == nil {
+			row = column
+		}
+		rows, cols, err := derivedGeneratorSize(options, len(column), len(row))
+		if err != nil {
+			return nil, nil, err
+		}
+		if row[0] != column[0] {
+			return nil, nil, fmt.Errorf("%w: el primer elemento de row debe coincidir con el de values", domain.ErrInvalidGeneratorOptions)
+		}
+		return generateFunction(rows, cols, func(i, j int) float64 {
+			if i >= j {
+				return column[i-j]
+			}
+			return row[j-i]
+		}), nil, nil
+
+	case domain.MatrixKindHankel:
+		column, row := options.Values, options.Row
+		if row == nil && len(column) > 0 {
+			row = make([]float64, len(column))
+			row[0] = column[len(column)-1]
+		}
+		rows, cols, err := derivedGeneratorSize(options, len(column), len(row))
+		if err != nil {
+			return nil, nil, err
+		}
+		if row[0] != column[rows-1] {
+			return nil, nil, fmt.Errorf("%w: el primer elemento de row debe coincidir con el último de values", domain.ErrInvalidGeneratorOptions)
+		}
+		return generateFunction(rows, cols, func(i, j int) float64 {
+			if i+j < rows {
+				return column[i+j]
+			}
+			return row[i+j-rows+1]
+		}), nil, nil
+
+	case domain.MatrixKindCirculant:
+		n := len(options.Values)
+		rows, cols, err := derivedGeneratorSize(options, n, n)
+		if err != nil {
+			return nil, nil, err
+		}
+		return generateFunction(rows, cols, func(i, j int) float64 {
+			return options.Values[(i-j+n)%n]
+		}), nil, nil
+
+	case domain.MatrixKindTridiagonal:
+		if len(options.Values) != 3 {
+			return nil, nil, fmt.Errorf("%w: tridiagonal requiere values = [inferior, principal, superior]", domain.ErrInvalidGeneratorOptions)
+		}
+		n, _, err := generatorSize(options.Rows, options.Cols)
+		if err != nil {
+			return nil, nil, err
+		}
+		if options.Cols != 0 && options.Cols != n {
+			return nil, nil, domain.ErrMatrixNotSquare
+		}
+		return generateFunction(n, n, func(i, j int) float64 {
+			if d := j - i + 1; d >= 0 && d <= 2 {
+				return options.Values[d]
+			}
+			return 0
+		}), nil, nil
+
+	case domain.MatrixKindUniform, domain.MatrixKindNormal, domain.MatrixKindOrthogonal:
+		rows, cols, err := generatorSize(options.Rows, options.Cols)
+		if err != nil {
+			return nil, nil, err
+		}
+		seed := resolveSeed(options.Seed)
+		rng := rand.New(rand.NewPCG(uint64(seed), 0))
+		matrix, err := generateRandom(rng, kind, rows, cols, options)
+		return matrix, &seed, err
+	}
+	return nil, nil, fmt.Errorf("%w: %q", domain.ErrUnsupportedMatrixKind, kind)
+}
+
+// structuredEntry devuelve el elemento (i, j) de las familias que solo dependen del tamaño.
+func structuredEntry(kind domain.MatrixKind) func(i, j int) float64 {
+	switch kind {
+	case domain.MatrixKindIdentity:
+		return func(i, j int) float64 {
+			if i == j {
+				return 1
+			}
+			return 0
+		}
+	case domain.MatrixKindOnes:
+		return func(_, _ int) float64 { return 1 }
+	case domain.MatrixKindHilbert:
+		return func(i, j int) float64 { return 1 / float64(i+j+1) }
+	}
+	return func(_, _ int) float64 { return 0 }
+}
+
+// generateRandom construye las familias aleatorias con rng.
+func generateRandom(rng *rand.Rand, kind domain.MatrixKind, rows, cols int, options domain.MatrixGeneratorOptions) (*mat.Dense, error) {
+	switch kind {
+	case domain.MatrixKindUniform:
+		high := 1.0
+		if options.High != nil {
+			high = *options.High
+		}
+		if !(options.Low < high) || math.IsInf(options.Low, 0) || math.IsInf(high, 0) {
+			return nil, fmt.Errorf("%w: uniform requiere low < high finitos", domain.ErrInvalidGeneratorOptions)
+		}
+		return generateFunction(rows, cols, func(_, _ int) float64 {
+			return options.Low + (high-options.Low)*rng.Float64()
+		}), nil
+
+	case domain.MatrixKindNormal:
+		stdDev := 1.0
+		if options.StdDev != nil {
+			stdDev = *options.StdDev
+		}
+		if !(stdDev >= 0) || math.IsInf(stdDev, 0) || math.IsNaN(options.Mean) || math.IsInf(options.Mean, 0) {
+			return nil, fmt.Errorf("%w: normal requiere mean finita y std_dev ≥ 0", domain.ErrInvalidGeneratorOptions)
+		}
+		return generateFunction(rows, cols, func(_, _ int) float64 {
+			return options.Mean + stdDev*rng.NormFloat64()
+		}), nil
+	}
+	return haarOrthogonal(rng, rows, cols), nil
+}
+
+// haarOrthogonal genera una matriz con columnas (o filas, si rows < cols) ortonormales
+// distribuida según la medida de Haar: se factoriza G = Q·R con G gaussiana y se cambia el
+// signo de cada columna de Q para que la diagonal de R sea positiva (Mezzadri, 2007). Sin esa
+// corrección la distribución de Q depende del algoritmo de QR y no es uniforme.
+func haarOrthogonal(rng *rand.Rand, rows, cols int) *mat.Dense {
+	m, n := max(rows, cols), min(rows, cols)
+	g := gaussianMatrix(rng, m, n)
+	q := orthonormalBasis(g)
+	for j := 0; j < n; j++ {
+		// rⱼⱼ = qⱼᵀ·gⱼ.
+		if mat.Dot(q.ColView(j), g.ColView(j)) < 0 {
+			column := q.ColView(j).(*mat.VecDense)
+			column.ScaleVec(-1, column)
+		}
+	}
+	if rows < cols {
+		return mat.DenseCopyOf(q.T())
+	}
+	return q
+}
+
+// generatorSize valida el tamaño de las familias definidas por rows y cols (0 usa rows).
+func generatorSize(rows, cols int) (int, int, error) {
+	if cols == 0 {
+		cols = rows
+	}
+	if rows < 1 || cols < 1 {
+		return 0, 0, fmt.Errorf("%w: rows y cols deben ser positivos", domain.ErrInvalidGeneratorOptions)
+	}
+	if exceedsMaxResultElements(rows, cols) {
+		return 0, 0, fmt.Errorf("%w: la matriz supera los %d elementos", domain.ErrInvalidGeneratorOptions, maxResultElements)
+	}
+	return rows, cols, nil
+}
+
+// derivedGeneratorSize valida el tamaño de las familias definidas por vectores de rows y cols
+// elementos: rows y cols de las opciones son opcionales, pero si se indican deben coincidir.
+func derivedGeneratorSize(options domain.MatrixGeneratorOptions, rows, cols int) (int, int, error) {
+	if rows == 0 || cols == 0 {
+		return 0, 0, fmt.Errorf("%w: values (y row, si se indica) no pueden estar vacíos", domain.ErrInvalidGeneratorOptions)
+	}
+	if (options.Rows != 0 && options.Rows != rows) || (options.Cols != 0 && options.Cols != cols) {
+		return 0, 0, fmt.Errorf("%w: el tamaño se deduce de los valores y debe ser %d×%d", domain.ErrInvalidGeneratorOptions, rows, cols)
+	}
+	return generatorSize(rows, cols)
+}
+
+// generateFunction construye una matriz de rows×cols con los elementos entry(i, j), en orden
+// por filas.
+func generateFunction(rows, cols int, entry func(i, j int) float64) *mat.Dense {
+	data := make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			data[i*cols+j] = entry(i, j)
+		}
+	}
+	return mat.NewDense(rows, cols, data)
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// AnalyzeMatrix valida la matriz y calcula en una sola pasada su rango, normas, número de
+// condición y, si es cuadrada, traza, determinante e inversa.
+func (uc *matrixUsecase) AnalyzeMatrix(matrix domain.Matrix, tolerance float64) (domain.MatrixPropertiesReport, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.MatrixPropertiesReport{}, err
+	}
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		return domain.MatrixPropertiesReport{}, domain.ErrInvalidTolerance
+	}
+
+	report, err := uc.analyzeMatrix(matrix, tolerance)
+	if err != nil {
+		return domain.MatrixPropertiesReport{}, fmt.Errorf("error al analizar la matriz: %w", err)
+	}
+	return report, nil
+}
+
+func (uc *matrixUsecase) analyzeMatrix(matrix domain.Matrix, tolerance float64) (domain.MatrixPropertiesReport, error) {
+	a := toDense(matrix)
+	rows, cols := a.Dims()
+
+	var svd mat.SVD
+	if ok := svd.Factorize(a, mat.SVDNone); !ok {
+		return domain.MatrixPropertiesReport{}, errors.New("la descomposición SVD no convergió")
+	}
+	values := svd.Values(nil)
+
+	report := domain.MatrixPropertiesReport{
+		Rows: rows,
+		Cols: cols,
+		Rank: rankFromSingularValues(values, rows, cols, tolerance),
+		Norms: domain.MatrixNorms{
+			One:       mat.Norm(a, 1),
+			Two:       values[0],
+			Inf:       mat.Norm(a, math.Inf(1)),
+			Frobenius: mat.Norm(a, 2),
+		},
+	}
+	if smallest := values[len(values)-1]; smallest > 0 {
+		condition := values[0] / smallest
+		report.ConditionNumber = &condition
+	}
+	if rows != cols {
+		return report, nil
+	}
+
+	trace := mat.Trace(a)
+	report.Trace = &trace
+
+	var lu mat.LU
+	lu.Factorize(a)
+	logDet, sign := lu.LogDet()
+	if sign != 0 && !math.IsInf(logDet, -1) {
+		report.LogDeterminant = &domain.LogDeterminant{Value: logDet, Sign: sign}
+	}
+	if det := lu.Det(); !math.IsInf(det, 0) && !math.IsNaN(det) {
+		report.Determinant = &det
+	}
+
+	if !luIsSingular(&lu, a) {
+		// Con mal condicionamiento la inversa se devuelve igualmente; condition_number
+		// permite al cliente valorar su precisión.
+		var inverse mat.Dense
+		if err := conditionError(inverse.Inverse(a)); err == nil || errors.Is(err, domain.ErrMatrixIllConditioned) {
+			report.Inverse = fromDense(&inverse)
+		}
+	}
+	return report, nil
+}
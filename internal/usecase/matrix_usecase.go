@@ -1,13 +1,23 @@
 package usecase
 
 import (
+	"errors"
 	"fmt"
 	"math"
 
 	"api-go/internal/domain"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
 	"gonum.org/v1/gonum/mat"
 )
 
+// machineEpsilon es la distancia entre 1 y el siguiente float64 representable.
+var machineEpsilon = math.Nextafter(1, 2) - 1
+
+// symmetryTolerance es la diferencia relativa máxima admitida entre a(i,j) y a(j,i)
+// para considerar simétrica una matriz.
+const symmetryTolerance = 1e-10
+
 // matrixUsecase implementa la interfaz MatrixUsecase.
 type matrixUsecase struct{}
 
@@ -16,50 +26,112 @@ func NewMatrixUsecase() MatrixUsecase {
 	return &matrixUsecase{}
 }
 
-// ProcessMatrix valida, rota y calcula la factorización QR de la matriz.
-func (uc *matrixUsecase) ProcessMatrix(originalMatrix domain.Matrix) (rotatedMatrix domain.Matrix, qrFactorization domain.QRFactorization, err error) {
-	rows := len(originalMatrix)
-	if rows == 0 {
-		return nil, domain.QRFactorization{}, domain.ErrMatrixEmpty
+// ProcessMatrix valida la matriz, le aplica la composición de transforms (por defecto un giro
+// de 90° en sentido horario) y calcula la factorización QR de la original según qrOptions.
+func (uc *matrixUsecase) ProcessMatrix(originalMatrix domain.Matrix, transforms []domain.MatrixTransform, qrOptions domain.QROptions) (transformedMatrix domain.Matrix, qrFactorization domain.QRFactorization, err error) {
+	if err := validateMatrix(originalMatrix); err != nil {
+		return nil, domain.QRFactorization{}, err
 	}
-	cols := len(originalMatrix[0])
-	if cols == 0 {
-		return nil, domain.QRFactorization{}, domain.ErrMatrixEmpty
+
+	transform, err := composeTransforms(transforms)
+	if err != nil {
+		return nil, domain.QRFactorization{}, err
 	}
-	for _, row := range originalMatrix {
-		if len(row) != cols {
-			return nil, domain.QRFactorization{}, domain.ErrMatrixNotRectangular
-		}
+	transformedMatrix = uc.transformMatrix(originalMatrix, transform)
+
+	qrFactorization, err = uc.factorizeQR(originalMatrix, qrOptions)
+	if err != nil {
+		return nil, domain.QRFactorization{}, fmt.Errorf("error al calcular la factorización QR: %w", err)
 	}
 
-	rotatedMatrix = uc.rotateMatrix90Degrees(originalMatrix)
+	return transformedMatrix, qrFactorization, nil
+}
 
-	qrFactorization, err = uc.factorizeQR(originalMatrix)
+// FactorizeLU valida la matriz y calcula su factorización LU con pivoteo parcial.
+func (uc *matrixUsecase) FactorizeLU(matrix domain.Matrix) (domain.LUFactorization, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return domain.LUFactorization{}, err
+	}
+
+	luFactorization, err := uc.factorizeLU(matrix)
 	if err != nil {
-		return nil, domain.QRFactorization{}, fmt.Errorf("error al calcular la factorización QR: %w", err)
+		return domain.LUFactorization{}, fmt.Errorf("error al calcular la factorización LU: %w", err)
+	}
+	return luFactorization, nil
+}
+
+// FactorizeCholesky valida que la matriz sea simétrica y calcula su factorización de Cholesky.
+func (uc *matrixUsecase) FactorizeCholesky(matrix domain.Matrix) (domain.CholeskyFactorization, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return domain.CholeskyFactorization{}, err
 	}
 
-	return rotatedMatrix, qrFactorization, nil
+	choleskyFactorization, err := uc.factorizeCholesky(matrix)
+	if err != nil {
+		return domain.CholeskyFactorization{}, fmt.Errorf("error al calcular la factorización de Cholesky: %w", err)
+	}
+	return choleskyFactorization, nil
 }
 
-func (uc *matrixUsecase) rotateMatrix90Degrees(matrix domain.Matrix) domain.Matrix {
-	rows := len(matrix)
-	cols := len(matrix[0])
+// DecomposeSVD valida la matriz y calcula su descomposición en valores singulares en el modo
+// indicado. Un modo vacío equivale a domain.SVDModeThin.
+func (uc *matrixUsecase) DecomposeSVD(matrix domain.Matrix, mode domain.SVDMode) (domain.SVDFactorization, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.SVDFactorization{}, err
+	}
 
-	rotated := make(domain.Matrix, cols)
-	for idx := range rotated {
-		rotated[idx] = make([]float64, rows)
+	svdFactorization, err := uc.decomposeSVD(matrix, mode)
+	if err != nil {
+		return domain.SVDFactorization{}, fmt.Errorf("error al calcular la descomposición SVD: %w", err)
 	}
+	return svdFactorization, nil
+}
 
-	for r := 0; r < rows; r++ {
-		for c := 0; c < cols; c++ {
-			rotated[c][rows-1-r] = matrix[r][c]
+// DecomposeEigen valida la matriz y calcula sus valores propios y, si se piden, sus vectores
+// propios izquierdos y/o derechos.
+func (uc *matrixUsecase) DecomposeEigen(matrix domain.Matrix, leftVectors, rightVectors bool) (domain.EigenDecomposition, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return domain.EigenDecomposition{}, err
+	}
+
+	eigenDecomposition, err := uc.decomposeEigen(matrix, leftVectors, rightVectors)
+	if err != nil {
+		return domain.EigenDecomposition{}, fmt.Errorf("error al calcular la descomposición espectral: %w", err)
+	}
+	return eigenDecomposition, nil
+}
+
+// validateMatrix comprueba que la matriz no esté vacía y que todas sus filas tengan la misma longitud.
+func validateMatrix(matrix domain.Matrix) error {
+	rows := len(matrix)
+	if rows == 0 {
+		return domain.ErrMatrixEmpty
+	}
+	cols := len(matrix[0])
+	if cols == 0 {
+		return domain.ErrMatrixEmpty
+	}
+	for _, row := range matrix {
+		if len(row) != cols {
+			return domain.ErrMatrixNotRectangular
 		}
 	}
-	return rotated
+	return nil
+}
+
+// validateSquareMatrix aplica validateMatrix y además exige que la matriz sea cuadrada.
+func validateSquareMatrix(matrix domain.Matrix) error {
+	if err := validateMatrix(matrix); err != nil {
+		return err
+	}
+	if len(matrix) != len(matrix[0]) {
+		return domain.ErrMatrixNotSquare
+	}
+	return nil
 }
 
-func (uc *matrixUsecase) factorizeQR(matrix domain.Matrix) (domain.QRFactorization, error) {
+// toDense copia una domain.Matrix ya validada en una mat.Dense de gonum.
+func toDense(matrix domain.Matrix) *mat.Dense {
 	rows := len(matrix)
 	cols := len(matrix[0])
 
@@ -67,33 +139,509 @@ func (uc *matrixUsecase) factorizeQR(matrix domain.Matrix) (domain.QRFactorizati
 	for r := 0; r < rows; r++ {
 		copy(flattened[r*cols:(r+1)*cols], matrix[r])
 	}
-	m := mat.NewDense(rows, cols, flattened)
+	return mat.NewDense(rows, cols, flattened)
+}
 
-	var qr mat.QR
-	qr.Factorize(m)
+// fromDense copia cualquier mat.Matrix de gonum en una domain.Matrix.
+func fromDense(m mat.Matrix) domain.Matrix {
+	rows, cols := m.Dims()
+	result := make(domain.Matrix, rows)
+	for r := 0; r < rows; r++ {
+		result[r] = make([]float64, cols)
+		for c := 0; c < cols; c++ {
+			result[r][c] = m.At(r, c)
+		}
+	}
+	return result
+}
+
+// toSymDense convierte una matriz cuadrada en una mat.SymDense, devolviendo ErrMatrixNotSymmetric
+// si algún par de elementos simétricos difiere más que la tolerancia relativa.
+func toSymDense(matrix domain.Matrix) (*mat.SymDense, error) {
+	return denseToSym(toDense(matrix))
+}
 
-	QMat := mat.NewDense(rows, cols, nil)
-	qr.QTo(QMat)
-	Q := make(domain.Matrix, rows)
+// denseToSym es la variante de toSymDense para matrices de gonum ya construidas.
+func denseToSym(a mat.Matrix) (*mat.SymDense, error) {
+	n, _ := a.Dims()
+	tolerance := symmetryTolerance * maxAbs(a)
+
+	sym := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			if math.Abs(a.At(i, j)-a.At(j, i)) > tolerance {
+				return nil, domain.ErrMatrixNotSymmetric
+			}
+			sym.SetSym(i, j, (a.At(i, j)+a.At(j, i))/2)
+		}
+	}
+	return sym, nil
+}
+
+// identity devuelve la matriz identidad de n×n.
+func identity(n int) *mat.Dense {
+	I := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		I.Set(i, i, 1)
+	}
+	return I
+}
+
+// fromCDense copia una mat.CDense de gonum en una domain.ComplexMatrix.
+func fromCDense(m *mat.CDense) domain.ComplexMatrix {
+	rows, cols := m.Dims()
+	result := make(domain.ComplexMatrix, rows)
 	for r := 0; r < rows; r++ {
-		Q[r] = make([]float64, cols)
+		result[r] = make([]domain.Complex, cols)
 		for c := 0; c < cols; c++ {
-			Q[r][c] = QMat.At(r, c)
+			result[r][c] = toComplex(m.At(r, c))
 		}
 	}
+	return result
+}
 
-	RMat := mat.NewDense(rows, cols, nil)
-	qr.RTo(RMat)
-	R := make(domain.Matrix, rows)
+// realToComplexMatrix copia una mat.Matrix real en una domain.ComplexMatrix con parte imaginaria nula.
+func realToComplexMatrix(m mat.Matrix) domain.ComplexMatrix {
+	rows, cols := m.Dims()
+	result := make(domain.ComplexMatrix, rows)
 	for r := 0; r < rows; r++ {
+		result[r] = make([]domain.Complex, cols)
+		for c := 0; c < cols; c++ {
+			result[r][c] = domain.Complex{Real: m.At(r, c)}
+		}
+	}
+	return result
+}
+
+// toComplex convierte un complex128 en su representación JSON.
+func toComplex(value complex128) domain.Complex {
+	return domain.Complex{Real: real(value), Imag: imag(value)}
+}
+
+// maxAbs devuelve el mayor valor absoluto entre los elementos de la matriz.
+func maxAbs(m mat.Matrix) float64 {
+	rows, cols := m.Dims()
+	largest := 0.0
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			largest = math.Max(largest, math.Abs(m.At(r, c)))
+		}
+	}
+	return largest
+}
+
+// factorizeQR calcula A = Q·R y recorta los factores a la forma pedida en options.Mode.
+// Para matrices anchas (m < n) los modos full y economy coinciden: Q es m×m y R es m×n.
+func (uc *matrixUsecase) factorizeQR(matrix domain.Matrix, options domain.QROptions) (domain.QRFactorization, error) {
+	rows := len(matrix)
+	cols := len(matrix[0])
+	k := min(rows, cols)
+
+	qCols, rRows := k, k
+	switch options.Mode {
+	case domain.QRModeEconomy, domain.QRModeROnly, "":
+	case domain.QRModeFull:
+		qCols, rRows = rows, rows
+	default:
+		return domain.QRFactorization{}, fmt.Errorf("%w: %q (use full, economy o r-only)", domain.ErrUnsupportedMode, options.Mode)
+	}
+
+	switch options.Algorithm {
+	case domain.QRAlgorithmHouseholder, "":
+	case domain.QRAlgorithmGramSchmidt, domain.QRAlgorithmGivens:
+		if options.Pivoting {
+			return domain.QRFactorization{}, fmt.Errorf("%w: el pivoteo de columnas solo está disponible con householder", domain.ErrUnsupportedMode)
+		}
+	default:
+		return domain.QRFactorization{}, fmt.Errorf("%w: algoritmo %q (use householder, gram-schmidt o givens)", domain.ErrUnsupportedMode, options.Algorithm)
+	}
+
+	if options.Tolerance < 0 || math.IsNaN(options.Tolerance) {
+		return domain.QRFactorization{}, domain.ErrInvalidTolerance
+	}
+
+	a := toDense(matrix)
+	var QMat, RMat *mat.Dense
+	var permutation []int
+	var trace []domain.QRStep
+	switch {
+	case options.Pivoting:
+		QMat, RMat, permutation = pivotedQR(a)
+		if options.Trace {
+			trace = householderTrace(permuteDenseColumns(a, permutation))
+		}
+	case options.Algorithm == domain.QRAlgorithmGramSchmidt:
+		var err error
+		QMat, RMat, trace, err = gramSchmidtQR(a, options.Trace)
+		if err != nil {
+			return domain.QRFactorization{}, err
+		}
+	case options.Algorithm == domain.QRAlgorithmGivens:
+		QMat, RMat, trace = givensQR(a, options.Trace)
+	default:
+		QMat, RMat = householderQR(a)
+		if options.Trace {
+			trace = householderTrace(a)
+		}
+	}
+	if options.Normalize {
+		normalizeQRSigns(QMat, RMat)
+	}
+
+	R := make(domain.Matrix, rRows)
+	for r := 0; r < rRows; r++ {
 		R[r] = make([]float64, cols)
 		for c := 0; c < cols; c++ {
 			R[r][c] = RMat.At(r, c)
-            if math.Abs(R[r][c]) < 1e-9 && r > c {
-                R[r][c] = 0.0
-            }
+			if math.Abs(R[r][c]) < 1e-9 && r > c {
+				R[r][c] = 0.0
+			}
+		}
+	}
+	result := domain.QRFactorization{R: R, Trace: trace}
+	if options.Pivoting {
+		rank := rankFromR(RMat, options.Tolerance)
+		result.Permutation = permutation
+		result.Rank = &rank
+	}
+	if options.Diagnostics {
+		result.Diagnostics = qrDiagnostics(a, permutation, QMat.Slice(0, rows, 0, k), RMat.Slice(0, k, 0, cols))
+	}
+	if options.Mode != domain.QRModeROnly {
+		result.Q = fromDense(QMat.Slice(0, rows, 0, qCols))
+	}
+	return result, nil
+}
+
+// householderQR devuelve la factorización QR completa de a: Q de m×m y R de m×n.
+//
+// mat.QR solo admite matrices con m ≥ n. Para matrices anchas se factoriza el bloque
+// cuadrado inicial A[:, :m] = Q·R₁ y las columnas restantes se proyectan como
+// R₂ = Qᵀ·A[:, m:], de modo que A = Q·[R₁ R₂] con [R₁ R₂] trapezoidal superior.
+func householderQR(a *mat.Dense) (Q, R *mat.Dense) {
+	rows, cols := a.Dims()
+
+	var qr mat.QR
+	Q, R = &mat.Dense{}, &mat.Dense{}
+	if rows >= cols {
+		qr.Factorize(a)
+		qr.QTo(Q)
+		qr.RTo(R)
+		return Q, R
+	}
+
+	qr.Factorize(a.Slice(0, rows, 0, rows))
+	qr.QTo(Q)
+	var R1 mat.Dense
+	qr.RTo(&R1)
+
+	R.ReuseAs(rows, cols)
+	R.Slice(0, rows, 0, rows).(*mat.Dense).Copy(&R1)
+	R.Slice(0, rows, rows, cols).(*mat.Dense).Mul(Q.T(), a.Slice(0, rows, rows, cols))
+	return Q, R
+}
+
+// pivotedQR calcula la factorización QR con pivoteo de columnas A·P = Q·R mediante
+// LAPACK Dgeqp3. Devuelve Q de m×m, R de m×n y la permutación aplicada: la columna j
+// de A·P es la columna permutation[j] de A. Admite matrices de cualquier forma.
+func pivotedQR(a *mat.Dense) (Q, R *mat.Dense, permutation []int) {
+	rows, cols := a.Dims()
+	k := min(rows, cols)
+
+	var factors mat.Dense
+	factors.CloneFrom(a)
+	raw := factors.RawMatrix()
+
+	permutation = make([]int, cols)
+	for j := range permutation {
+		permutation[j] = -1
+	}
+	tau := make([]float64, k)
+	work := []float64{0}
+	lapack64.Geqp3(raw, permutation, tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapack64.Geqp3(raw, permutation, tau, work, len(work))
+
+	R = mat.NewDense(rows, cols, nil)
+	for r := 0; r < k; r++ {
+		for c := r; c < cols; c++ {
+			R.Set(r, c, factors.At(r, c))
+		}
+	}
+
+	Q = mat.NewDense(rows, rows, nil)
+	Q.Slice(0, rows, 0, k).(*mat.Dense).Copy(factors.Slice(0, rows, 0, k))
+	work = []float64{0}
+	lapack64.Orgqr(Q.RawMatrix(), tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapack64.Orgqr(Q.RawMatrix(), tau, work, len(work))
+
+	return Q, R, permutation
+}
+
+// normalizeQRSigns cambia el signo de la fila i de R y de la columna i de Q cuando Rᵢᵢ < 0.
+// El producto Q·R no varía y, para A de rango completo, la factorización resultante es única,
+// lo que hace comparables los resultados entre versiones de gonum.
+func normalizeQRSigns(Q, R *mat.Dense) {
+	rows, cols := R.Dims()
+	for i := 0; i < min(rows, cols); i++ {
+		if R.At(i, i) >= 0 {
+			continue
+		}
+		for c := 0; c < cols; c++ {
+			R.Set(i, c, -R.At(i, c))
+		}
+		for r := 0; r < rows; r++ {
+			Q.Set(r, i, -Q.At(r, i))
+		}
+	}
+}
+
+// qrDiagnostics mide la calidad de los factores reducidos Q (m×k) y R (k×n) de a. Si
+// permutation no es nil, el residuo se calcula frente a A·P.
+func qrDiagnostics(a *mat.Dense, permutation []int, Q, R mat.Matrix) *domain.QRDiagnostics {
+	rows, cols := a.Dims()
+	k := min(rows, cols)
+
+	target := a
+	if permutation != nil {
+		target = permuteDenseColumns(a, permutation)
+	}
+
+	var residual mat.Dense
+	residual.Mul(Q, R)
+	residual.Sub(target, &residual)
+
+	var gram mat.Dense
+	gram.Mul(Q.T(), Q)
+	for i := 0; i < k; i++ {
+		gram.Set(i, i, gram.At(i, i)-1)
+	}
+
+	diagnostics := &domain.QRDiagnostics{
+		ReconstructionResidual: mat.Norm(&residual, 2),
+		OrthogonalityError:     mat.Norm(&gram, 2),
+	}
+
+	triangular := mat.NewTriDense(k, mat.Upper, nil)
+	triangular.Copy(R)
+	rcond := lapack64.Trcon(lapack.MaxColumnSum, triangular.RawTriangular(), make([]float64, 3*k), make([]int, k))
+	if rcond > 0 {
+		condition := 1 / rcond
+		diagnostics.ConditionEstimate = &condition
+	}
+	return diagnostics
+}
+
+// permuteDenseColumns devuelve A·P, cuya columna j es la columna permutation[j] de a.
+func permuteDenseColumns(a *mat.Dense, permutation []int) *mat.Dense {
+	rows, cols := a.Dims()
+	permuted := mat.NewDense(rows, cols, nil)
+	for j, source := range permutation {
+		permuted.SetCol(j, mat.Col(nil, source, a))
+	}
+	return permuted
+}
+
+// rankFromR estima el rango numérico contando los elementos de la diagonal de R cuyo valor
+// absoluto supera tolerance·|R₀₀|. Con pivoteo de columnas la diagonal es no creciente en
+// valor absoluto, por lo que el conteo se detiene en el primer elemento despreciable.
+// Una tolerancia nula se sustituye por max(m, n)·ε.
+func rankFromR(R mat.Matrix, tolerance float64) int {
+	rows, cols := R.Dims()
+	if tolerance == 0 {
+		tolerance = float64(max(rows, cols)) * machineEpsilon
+	}
+
+	threshold := tolerance * math.Abs(R.At(0, 0))
+	rank := 0
+	for i := 0; i < min(rows, cols); i++ {
+		if math.Abs(R.At(i, i)) <= threshold || R.At(i, i) == 0 {
+			break
+		}
+		rank++
+	}
+	return rank
+}
+
+// luIsSingular indica si algún pivote de la factorización LU de a es despreciable, es decir,
+// no supera n·ε·max|aᵢⱼ|.
+func luIsSingular(lu *mat.LU, a mat.Matrix) bool {
+	n, _ := a.Dims()
+	var U mat.TriDense
+	lu.UTo(&U)
+
+	tolerance := float64(n) * machineEpsilon * maxAbs(a)
+	for i := 0; i < n; i++ {
+		if math.Abs(U.At(i, i)) <= tolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// rankFromSingularValues cuenta los valores singulares (en orden descendente) que superan
+// tolerance·σ₀. Una tolerancia nula se sustituye por max(m, n)·ε.
+func rankFromSingularValues(values []float64, rows, cols int, tolerance float64) int {
+	if tolerance == 0 {
+		tolerance = float64(max(rows, cols)) * machineEpsilon
+	}
+	if len(values) == 0 || values[0] == 0 {
+		return 0
+	}
+
+	threshold := tolerance * values[0]
+	rank := 0
+	for _, value := range values {
+		if value <= threshold {
+			break
 		}
+		rank++
+	}
+	return rank
+}
+
+// factorizeLU calcula A = P·L·U y devuelve ErrMatrixSingular si algún pivote de U
+// es despreciable frente a la magnitud de A.
+func (uc *matrixUsecase) factorizeLU(matrix domain.Matrix) (domain.LUFactorization, error) {
+	n := len(matrix)
+	m := toDense(matrix)
+
+	var lu mat.LU
+	lu.Factorize(m)
+
+	if luIsSingular(&lu, m) {
+		return domain.LUFactorization{}, domain.ErrMatrixSingular
+	}
+
+	var L, U mat.TriDense
+	lu.LTo(&L)
+	lu.UTo(&U)
+
+	P := mat.NewDense(n, n, nil)
+	for i, pivot := range lu.RowPivots(nil) {
+		P.Set(i, pivot, 1)
+	}
+
+	return domain.LUFactorization{
+		P: fromDense(P),
+		L: fromDense(&L),
+		U: fromDense(&U),
+	}, nil
+}
+
+// factorizeCholesky calcula A = L·Lᵀ y devuelve ErrMatrixNotPositiveDefinite si gonum
+// no logra completar la factorización.
+func (uc *matrixUsecase) factorizeCholesky(matrix domain.Matrix) (domain.CholeskyFactorization, error) {
+	sym, err := toSymDense(matrix)
+	if err != nil {
+		return domain.CholeskyFactorization{}, err
+	}
+
+	var chol mat.Cholesky
+	if ok := chol.Factorize(sym); !ok {
+		return domain.CholeskyFactorization{}, domain.ErrMatrixNotPositiveDefinite
+	}
+
+	var L mat.TriDense
+	chol.LTo(&L)
+
+	return domain.CholeskyFactorization{L: fromDense(&L)}, nil
+}
+
+// decomposeSVD calcula A = U·Σ·Vᵀ con mat.SVD. Admite matrices de cualquier forma.
+func (uc *matrixUsecase) decomposeSVD(matrix domain.Matrix, mode domain.SVDMode) (domain.SVDFactorization, error) {
+	var kind mat.SVDKind
+	switch mode {
+	case domain.SVDModeFull:
+		kind = mat.SVDFull
+	case domain.SVDModeThin, "":
+		kind = mat.SVDThin
+	case domain.SVDModeValues:
+		kind = mat.SVDNone
+	default:
+		return domain.SVDFactorization{}, fmt.Errorf("%w: %q (use full, thin o values)", domain.ErrUnsupportedMode, mode)
+	}
+
+	var svd mat.SVD
+	if ok := svd.Factorize(toDense(matrix), kind); !ok {
+		return domain.SVDFactorization{}, errors.New("la descomposición SVD no convergió")
 	}
 
-	return domain.QRFactorization{Q: Q, R: R}, nil
-}
\ No newline at end of file
+	result := domain.SVDFactorization{Sigma: svd.Values(nil)}
+	if kind == mat.SVDNone {
+		return result, nil
+	}
+
+	var U, V mat.Dense
+	svd.UTo(&U)
+	svd.VTo(&V)
+	result.U = fromDense(&U)
+	result.VT = fromDense(V.T())
+	return result, nil
+}
+
+// decomposeEigen usa mat.EigenSym cuando la matriz es simétrica, de modo que los valores y
+// vectores propios son reales y los vectores izquierdos coinciden con los derechos. En otro
+// caso recurre a mat.Eigen, que admite valores y vectores propios complejos.
+func (uc *matrixUsecase) decomposeEigen(matrix domain.Matrix, leftVectors, rightVectors bool) (domain.EigenDecomposition, error) {
+	if sym, err := toSymDense(matrix); err == nil {
+		return uc.decomposeEigenSym(sym, leftVectors, rightVectors)
+	}
+
+	var kind mat.EigenKind
+	if leftVectors {
+		kind |= mat.EigenLeft
+	}
+	if rightVectors {
+		kind |= mat.EigenRight
+	}
+
+	var eigen mat.Eigen
+	if ok := eigen.Factorize(toDense(matrix), kind); !ok {
+		return domain.EigenDecomposition{}, errors.New("el cálculo de valores propios no convergió")
+	}
+
+	values := eigen.Values(nil)
+	result := domain.EigenDecomposition{Values: make([]domain.Complex, len(values))}
+	for i, value := range values {
+		result.Values[i] = toComplex(value)
+	}
+	if leftVectors {
+		var left mat.CDense
+		eigen.LeftVectorsTo(&left)
+		result.LeftVectors = fromCDense(&left)
+	}
+	if rightVectors {
+		var right mat.CDense
+		eigen.VectorsTo(&right)
+		result.RightVectors = fromCDense(&right)
+	}
+	return result, nil
+}
+
+func (uc *matrixUsecase) decomposeEigenSym(sym *mat.SymDense, leftVectors, rightVectors bool) (domain.EigenDecomposition, error) {
+	var eigen mat.EigenSym
+	if ok := eigen.Factorize(sym, leftVectors || rightVectors); !ok {
+		return domain.EigenDecomposition{}, errors.New("el cálculo de valores propios no convergió")
+	}
+
+	values := eigen.Values(nil)
+	result := domain.EigenDecomposition{Symmetric: true, Values: make([]domain.Complex, len(values))}
+	for i, value := range values {
+		result.Values[i] = domain.Complex{Real: value}
+	}
+	if !leftVectors && !rightVectors {
+		return result, nil
+	}
+
+	var vectors mat.Dense
+	eigen.VectorsTo(&vectors)
+	if leftVectors {
+		result.LeftVectors = realToComplexMatrix(&vectors)
+	}
+	if rightVectors {
+		result.RightVectors = realToComplexMatrix(&vectors)
+	}
+	return result, nil
+}
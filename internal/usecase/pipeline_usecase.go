@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"api-go/internal/domain"
+)
+
+// maxPipelineSteps limita el número de pasos de un pipeline.
+const maxPipelineSteps = 64
+
+// pipelineOutput es la salida de un paso del pipeline. matrix es la matriz que pasa al paso
+// siguiente por defecto (nil si el resultado no tiene una matriz natural) y factors son las
+// matrices que se pueden elegir con take.
+type pipelineOutput struct {
+	result  interface{}
+	matrix  domain.Matrix
+	factors map[string]domain.Matrix
+}
+
+// pipelineOperation ejecuta un paso del pipeline sobre matrix.
+type pipelineOperation func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error)
+
+// pipelineOperations relaciona cada operación del pipeline, salvo las transformaciones del
+// grupo diédrico, con su implementación. Cada una delega en el caso de uso correspondiente,
+// que valida la matriz.
+var pipelineOperations = map[string]pipelineOperation{
+	"rotate": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		k := 1
+		if step.K != nil {
+			k = *step.K
+		}
+		transformed := uc.transformMatrix(matrix, dihedralElement{quarterTurns: (k%4 + 4) % 4})
+		return pipelineOutput{result: transformed, matrix: transformed}, nil
+	},
+	"transform": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		if len(step.Transform) == 0 {
+			return pipelineOutput{}, fmt.Errorf("%w: transform requiere al menos una transformación", domain.ErrInvalidPipeline)
+		}
+		g, err := composeTransforms(step.Transform)
+		if err != nil {
+			return pipelineOutput{}, err
+		}
+		transformed := uc.transformMatrix(matrix, g)
+		return pipelineOutput{result: transformed, matrix: transformed}, nil
+	},
+	"qr": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		qr, err := uc.factorizeQR(matrix, domain.QROptions{
+			Mode:        domain.QRMode(step.Mode),
+			Pivoting:    step.Pivoting,
+			Tolerance:   step.Tolerance,
+			Diagnostics: step.Diagnostics,
+			Normalize:   step.Normalize,
+			Algorithm:   domain.QRAlgorithm(step.Algorithm),
+			Trace:       step.Trace,
+		})
+		factors := map[string]domain.Matrix{"R": qr.R}
+		if qr.Q != nil {
+			factors["Q"] = qr.Q
+		}
+		return pipelineOutput{result: qr, factors: factors}, err
+	},
+	"lu": func(uc *matrixUsecase, matrix domain.Matrix, _ domain.PipelineStep) (pipelineOutput, error) {
+		lu, err := uc.FactorizeLU(matrix)
+		return pipelineOutput{result: lu, factors: map[string]domain.Matrix{"P": lu.P, "L": lu.L, "U": lu.U}}, err
+	},
+	"cholesky": func(uc *matrixUsecase, matrix domain.Matrix, _ domain.PipelineStep) (pipelineOutput, error) {
+		cholesky, err := uc.FactorizeCholesky(matrix)
+		return pipelineOutput{result: cholesky, factors: map[string]domain.Matrix{"L": cholesky.L}}, err
+	},
+	"svd": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		svd, err := uc.DecomposeSVD(matrix, domain.SVDMode(step.Mode))
+		factors := map[string]domain.Matrix{}
+		if svd.U != nil {
+			factors["U"], factors["VT"] = svd.U, svd.VT
+		}
+		return pipelineOutput{result: svd, factors: factors}, err
+	},
+	"eigen": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		eigen, err := uc.DecomposeEigen(matrix, false, step.Vectors)
+		return pipelineOutput{result: eigen}, err
+	},
+	"analyze": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		report, err := uc.AnalyzeMatrix(matrix, step.Tolerance)
+		factors := map[string]domain.Matrix{}
+		if report.Inverse != nil {
+			factors["inverse"] = report.Inverse
+		}
+		return pipelineOutput{result: report, factors: factors}, err
+	},
+	"hessenberg": func(uc *matrixUsecase, matrix domain.Matrix, _ domain.PipelineStep) (pipelineOutput, error) {
+		hessenberg, err := uc.ReduceHessenberg(matrix)
+		return pipelineOutput{result: hessenberg, factors: map[string]domain.Matrix{"H": hessenberg.H, "Q": hessenberg.Q}}, err
+	},
+	"schur": func(uc *matrixUsecase, matrix domain.Matrix, _ domain.PipelineStep) (pipelineOutput, error) {
+		schur, err := uc.DecomposeSchur(matrix)
+		return pipelineOutput{result: schur, factors: map[string]domain.Matrix{"T": schur.T, "Z": schur.Z}}, err
+	},
+	"polar": func(uc *matrixUsecase, matrix domain.Matrix, _ domain.PipelineStep) (pipelineOutput, error) {
+		polar, err := uc.DecomposePolar(matrix)
+		return pipelineOutput{result: polar, factors: map[string]domain.Matrix{"U": polar.U, "P": polar.P}}, err
+	},
+	"pseudoinverse": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		pinv, err := uc.Pseudoinverse(matrix, step.Tolerance)
+		return pipelineOutput{result: pinv, matrix: pinv.Pinv}, err
+	},
+	"rref": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		rref, err := uc.ReduceRowEchelon(matrix, step.Tolerance)
+		return pipelineOutput{result: rref, matrix: rref.RREF}, err
+	},
+	"function": func(uc *matrixUsecase, matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+		t := 1.0
+		if step.T != nil {
+			t = *step.T
+		}
+		result, err := uc.EvaluateMatrixFunction(matrix, step.Function, step.Power, t)
+		return pipelineOutput{result: result, matrix: result.Result}, err
+	},
+}
+
+// RunPipeline valida la matriz y ejecuta los pasos en orden: cada uno recibe la matriz que
+// produce el anterior. Si intermediate es true se devuelve además el resultado de cada paso.
+func (uc *matrixUsecase) RunPipeline(matrix domain.Matrix, steps []domain.PipelineStep, intermediate bool) (domain.PipelineResult, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.PipelineResult{}, err
+	}
+	if len(steps) == 0 {
+		return domain.PipelineResult{}, fmt.Errorf("%w: el pipeline no tiene pasos", domain.ErrInvalidPipeline)
+	}
+	if len(steps) > maxPipelineSteps {
+		return domain.PipelineResult{}, fmt.Errorf("%w: el pipeline no puede tener más de %d pasos", domain.ErrInvalidPipeline, maxPipelineSteps)
+	}
+
+	result := domain.PipelineResult{}
+	current := matrix
+	for i, step := range steps {
+		output, err := uc.runPipelineStep(current, step)
+		if err != nil {
+			return domain.PipelineResult{}, fmt.Errorf("paso %d (%s): %w", i+1, step.Op, err)
+		}
+		if intermediate {
+			result.Steps = append(result.Steps, domain.PipelineStepResult{Op: step.Op, Result: output.result})
+		}
+
+		result.Result, current = output.result, output.matrix
+		if step.Take != "" {
+			factor, ok := output.factors[step.Take]
+			if !ok {
+				return domain.PipelineResult{}, fmt.Errorf("%w: paso %d (%s): take %q no es válido%s", domain.ErrInvalidPipeline, i+1, step.Op, step.Take, factorHint(output.factors))
+			}
+			result.Result, current = factor, factor
+		}
+		if current == nil && i < len(steps)-1 {
+			return domain.PipelineResult{}, fmt.Errorf("%w: paso %d (%s): el resultado no es una matriz%s", domain.ErrInvalidPipeline, i+1, step.Op, factorHint(output.factors))
+		}
+	}
+	return result, nil
+}
+
+// runPipelineStep ejecuta un paso. Las transformaciones del grupo diédrico (transpose,
+// flip-horizontal, rotate-cw-90, ...) se admiten directamente como operaciones.
+func (uc *matrixUsecase) runPipelineStep(matrix domain.Matrix, step domain.PipelineStep) (pipelineOutput, error) {
+	if g, ok := dihedralElements[domain.MatrixTransform(step.Op)]; ok {
+		transformed := uc.transformMatrix(matrix, g)
+		return pipelineOutput{result: transformed, matrix: transformed}, nil
+	}
+	operation, ok := pipelineOperations[step.Op]
+	if !ok {
+		return pipelineOutput{}, fmt.Errorf("%w: operación desconocida %q", domain.ErrInvalidPipeline, step.Op)
+	}
+	return operation(uc, matrix, step)
+}
+
+// factorHint describe los factores que se pueden elegir con take, o indica que el paso debe
+// ser el último si no hay ninguno.
+func factorHint(factors map[string]domain.Matrix) string {
+	if len(factors) == 0 {
+		return "; esta operación debe ser el último paso"
+	}
+	names := make([]string, 0, len(factors))
+	for name := range factors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("; indique take con uno de: %s", strings.Join(names, ", "))
+}
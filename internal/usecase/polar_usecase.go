@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// DecomposePolar valida la matriz y calcula su descomposición polar A = U·P.
+func (uc *matrixUsecase) DecomposePolar(matrix domain.Matrix) (domain.PolarDecomposition, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.PolarDecomposition{}, err
+	}
+
+	polarDecomposition, err := uc.decomposePolar(matrix)
+	if err != nil {
+		return domain.PolarDecomposition{}, fmt.Errorf("error al calcular la descomposición polar: %w", err)
+	}
+	return polarDecomposition, nil
+}
+
+// SolveProcrustes valida ambas nubes de puntos y calcula la matriz ortogonal R que mejor
+// alinea A con B, es decir, que minimiza ‖A·R − B‖_F.
+func (uc *matrixUsecase) SolveProcrustes(a, b domain.Matrix, properRotation bool) (domain.ProcrustesSolution, error) {
+	if err := validateMatrix(a); err != nil {
+		return domain.ProcrustesSolution{}, fmt.Errorf("matriz A: %w", err)
+	}
+	if err := validateMatrix(b); err != nil {
+		return domain.ProcrustesSolution{}, fmt.Errorf("matriz B: %w", err)
+	}
+	if len(a) != len(b) || len(a[0]) != len(b[0]) {
+		return domain.ProcrustesSolution{}, fmt.Errorf("%w: A es %dx%d y B es %dx%d", domain.ErrDimensionMismatch, len(a), len(a[0]), len(b), len(b[0]))
+	}
+
+	solution, err := uc.solveProcrustes(a, b, properRotation)
+	if err != nil {
+		return domain.ProcrustesSolution{}, fmt.Errorf("error al resolver el problema de Procrustes: %w", err)
+	}
+	return solution, nil
+}
+
+// decomposePolar parte de la SVD reducida A = W·Σ·Vᵀ: U = W·Vᵀ y P = V·Σ·Vᵀ.
+func (uc *matrixUsecase) decomposePolar(matrix domain.Matrix) (domain.PolarDecomposition, error) {
+	var svd mat.SVD
+	if ok := svd.Factorize(toDense(matrix), mat.SVDThin); !ok {
+		return domain.PolarDecomposition{}, errors.New("la descomposición SVD no convergió")
+	}
+
+	var W, V mat.Dense
+	svd.UTo(&W)
+	svd.VTo(&V)
+
+	var U mat.Dense
+	U.Mul(&W, V.T())
+
+	scaled := mat.DenseCopyOf(&V)
+	for j, value := range svd.Values(nil) {
+		column := scaled.ColView(j).(*mat.VecDense)
+		column.ScaleVec(value, column)
+	}
+	var P mat.Dense
+	P.Mul(scaled, V.T())
+
+	return domain.PolarDecomposition{U: fromDense(&U), P: fromDense(&P)}, nil
+}
+
+// solveProcrustes resuelve el problema de Procrustes ortogonal: con la SVD Aᵀ·B = W·Σ·Vᵀ, la
+// solución es R = W·Vᵀ, el factor ortogonal de la descomposición polar de Aᵀ·B. Si se exige
+// una rotación propia y det(R) < 0, se invierte la última columna de W (algoritmo de Kabsch).
+func (uc *matrixUsecase) solveProcrustes(a, b domain.Matrix, properRotation bool) (domain.ProcrustesSolution, error) {
+	A, B := toDense(a), toDense(b)
+
+	var cross mat.Dense
+	cross.Mul(A.T(), B)
+
+	var svd mat.SVD
+	if ok := svd.Factorize(&cross, mat.SVDFull); !ok {
+		return domain.ProcrustesSolution{}, errors.New("la descomposición SVD no convergió")
+	}
+	var W, V mat.Dense
+	svd.UTo(&W)
+	svd.VTo(&V)
+
+	var R mat.Dense
+	R.Mul(&W, V.T())
+	if properRotation && mat.Det(&R) < 0 {
+		d, _ := W.Dims()
+		last := W.ColView(d - 1).(*mat.VecDense)
+		last.ScaleVec(-1, last)
+		R.Mul(&W, V.T())
+	}
+
+	var residual mat.Dense
+	residual.Mul(A, &R)
+	residual.Sub(&residual, B)
+
+	return domain.ProcrustesSolution{Rotation: fromDense(&R), Residual: mat.Norm(&residual, 2)}, nil
+}
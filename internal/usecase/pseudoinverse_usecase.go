@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Pseudoinverse valida la matriz y calcula su pseudoinversa de Moore–Penrose. tolerance es
+// relativa al mayor valor singular; si es 0 se usa max(m, n)·ε.
+func (uc *matrixUsecase) Pseudoinverse(matrix domain.Matrix, tolerance float64) (domain.Pseudoinverse, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.Pseudoinverse{}, err
+	}
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		return domain.Pseudoinverse{}, domain.ErrInvalidTolerance
+	}
+
+	pinv, err := uc.pseudoinverse(matrix, tolerance)
+	if err != nil {
+		return domain.Pseudoinverse{}, fmt.Errorf("error al calcular la pseudoinversa: %w", err)
+	}
+	return pinv, nil
+}
+
+// pseudoinverse calcula A⁺ = V·Σ⁺·Uᵀ a partir de la SVD reducida, invirtiendo solo los
+// valores singulares que superan el umbral.
+func (uc *matrixUsecase) pseudoinverse(matrix domain.Matrix, tolerance float64) (domain.Pseudoinverse, error) {
+	a := toDense(matrix)
+	rows, cols := a.Dims()
+	if tolerance == 0 {
+		tolerance = float64(max(rows, cols)) * machineEpsilon
+	}
+
+	var svd mat.SVD
+	if ok := svd.Factorize(a, mat.SVDThin); !ok {
+		return domain.Pseudoinverse{}, errors.New("la descomposición SVD no convergió")
+	}
+	values := svd.Values(nil)
+	rank := rankFromSingularValues(values, rows, cols, tolerance)
+
+	var U, V mat.Dense
+	svd.UTo(&U)
+	svd.VTo(&V)
+
+	// Solo las primeras rank columnas de V y U contribuyen: A⁺ = V_r·Σ_r⁻¹·U_rᵀ.
+	pinv := mat.NewDense(cols, rows, nil)
+	if rank > 0 {
+		scaled := mat.DenseCopyOf(V.Slice(0, cols, 0, rank))
+		for j := 0; j < rank; j++ {
+			column := scaled.ColView(j).(*mat.VecDense)
+			column.ScaleVec(1/values[j], column)
+		}
+		pinv.Mul(scaled, U.Slice(0, rows, 0, rank).T())
+	}
+
+	return domain.Pseudoinverse{Pinv: fromDense(pinv), Rank: rank, Tolerance: tolerance}, nil
+}
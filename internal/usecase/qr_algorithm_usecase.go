@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/lapack/lapack64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// householderTrace reconstruye los reflectores de Householder que LAPACK Dgeqrf aplica a a
+// (los mismos que usa mat.QR) y registra la matriz parcialmente reducida tras cada uno.
+// Dgeqrf guarda vᵢ bajo la diagonal de la columna i, con vᵢ[i] = 1 implícito.
+func householderTrace(a *mat.Dense) []domain.QRStep {
+	rows, cols := a.Dims()
+	k := min(rows, cols)
+
+	var factors mat.Dense
+	factors.CloneFrom(a)
+	tau := make([]float64, k)
+	work := []float64{0}
+	lapack64.Geqrf(factors.RawMatrix(), tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapack64.Geqrf(factors.RawMatrix(), tau, work, len(work))
+
+	working := mat.DenseCopyOf(a)
+	steps := make([]domain.QRStep, 0, k)
+	for i := 0; i < k; i++ {
+		v := mat.NewVecDense(rows, nil)
+		v.SetVec(i, 1)
+		for r := i + 1; r < rows; r++ {
+			v.SetVec(r, factors.At(r, i))
+		}
+
+		// H·W = W − τ·v·(Wᵀ·v)ᵀ
+		var projection mat.VecDense
+		projection.MulVec(working.T(), v)
+		working.RankOne(working, -tau[i], v, &projection)
+
+		stepTau := tau[i]
+		steps = append(steps, domain.QRStep{
+			Kind:        domain.QRStepReflector,
+			Description: fmt.Sprintf("H%d = I − τ·v·vᵀ anula la columna %d bajo la diagonal", i+1, i+1),
+			Column:      i,
+			Vector:      mat.Col(nil, 0, v),
+			Tau:         &stepTau,
+			Matrix:      fromDense(working),
+		})
+	}
+	return steps
+}
+
+// gramSchmidtQR calcula A = Q·R con Gram–Schmidt modificado: al normalizar la columna i se
+// proyecta qᵢ fuera de todas las columnas posteriores, en lugar de proyectar cada columna
+// contra las anteriores como en la versión clásica. Devuelve Q de m×m (las columnas
+// posteriores a k completan una base ortonormal) y R de m×n. Requiere que las primeras k
+// columnas de A sean linealmente independientes.
+func gramSchmidtQR(a *mat.Dense, trace bool) (Q, R *mat.Dense, steps []domain.QRStep, err error) {
+	rows, cols := a.Dims()
+	k := min(rows, cols)
+	tolerance := float64(max(rows, cols)) * machineEpsilon * mat.Norm(a, 2)
+
+	V := mat.DenseCopyOf(a)
+	R = mat.NewDense(rows, cols, nil)
+	for i := 0; i < k; i++ {
+		norm := mat.Norm(V.ColView(i), 2)
+		if norm <= tolerance {
+			return nil, nil, nil, fmt.Errorf("%w: Gram–Schmidt requiere columnas linealmente independientes (la columna %d depende de las anteriores)", domain.ErrMatrixSingular, i+1)
+		}
+		R.Set(i, i, norm)
+		q := mat.VecDenseCopyOf(V.ColView(i))
+		q.ScaleVec(1/norm, q)
+		V.SetCol(i, q.RawVector().Data)
+		if trace {
+			coefficient := norm
+			steps = append(steps, domain.QRStep{
+				Kind:        domain.QRStepNormalization,
+				Description: fmt.Sprintf("q%d = v%d / ‖v%d‖", i+1, i+1, i+1),
+				Column:      i,
+				Vector:      mat.Col(nil, 0, q),
+				Coefficient: &coefficient,
+				Matrix:      fromDense(V),
+			})
+		}
+
+		for j := i + 1; j < cols; j++ {
+			column := V.ColView(j).(*mat.VecDense)
+			coefficient := mat.Dot(q, column)
+			R.Set(i, j, coefficient)
+			column.AddScaledVec(column, -coefficient, q)
+			if trace {
+				basis := i
+				steps = append(steps, domain.QRStep{
+					Kind:        domain.QRStepProjection,
+					Description: fmt.Sprintf("v%d ← v%d − r%d%d·q%d", j+1, j+1, i+1, j+1, i+1),
+					Column:      j,
+					Basis:       &basis,
+					Coefficient: &coefficient,
+					Matrix:      fromDense(V),
+				})
+			}
+		}
+	}
+
+	return completeOrthonormalBasis(V.Slice(0, rows, 0, k).(*mat.Dense)), R, steps, nil
+}
+
+// completeOrthonormalBasis extiende las k columnas ortonormales de q (m×k) a una matriz
+// ortogonal de m×m. Las columnas añadidas son las últimas m − k columnas del Q completo de
+// la factorización de Householder de q, que generan el complemento ortogonal de su imagen.
+func completeOrthonormalBasis(q *mat.Dense) *mat.Dense {
+	rows, k := q.Dims()
+	Q := mat.NewDense(rows, rows, nil)
+	Q.Slice(0, rows, 0, k).(*mat.Dense).Copy(q)
+	if k < rows {
+		complement, _ := householderQR(q)
+		Q.Slice(0, rows, k, rows).(*mat.Dense).Copy(complement.Slice(0, rows, k, rows))
+	}
+	return Q
+}
+
+// givensQR calcula A = Q·R anulando los elementos bajo la diagonal columna a columna, de abajo
+// arriba, con rotaciones de Givens entre filas adyacentes. Cada rotación G cumple
+// G·[a, b]ᵀ = [r, 0]ᵀ con r = √(a² + b²), c = a/r y s = b/r; Q acumula las Gᵀ. Devuelve Q de
+// m×m y R de m×n.
+func givensQR(a *mat.Dense, trace bool) (Q, R *mat.Dense, steps []domain.QRStep) {
+	rows, cols := a.Dims()
+	R = mat.DenseCopyOf(a)
+	Q = identity(rows)
+
+	for j := 0; j < min(rows-1, cols); j++ {
+		for i := rows - 1; i > j; i-- {
+			bottom := R.At(i, j)
+			if bottom == 0 {
+				continue
+			}
+			top := R.At(i-1, j)
+			radius := math.Hypot(top, bottom)
+			c, s := top/radius, bottom/radius
+
+			for col := j; col < cols; col++ {
+				x, y := R.At(i-1, col), R.At(i, col)
+				R.Set(i-1, col, c*x+s*y)
+				R.Set(i, col, -s*x+c*y)
+			}
+			R.Set(i, j, 0)
+			for row := 0; row < rows; row++ {
+				x, y := Q.At(row, i-1), Q.At(row, i)
+				Q.Set(row, i-1, c*x+s*y)
+				Q.Set(row, i, -s*x+c*y)
+			}
+
+			if trace {
+				steps = append(steps, domain.QRStep{
+					Kind:        domain.QRStepRotation,
+					Description: fmt.Sprintf("G(%d, %d) anula el elemento (%d, %d)", i, i+1, i+1, j+1),
+					Column:      j,
+					Rows:        []int{i - 1, i},
+					Cosine:      &c,
+					Sine:        &s,
+					Matrix:      fromDense(R),
+				})
+			}
+		}
+	}
+	return Q, R, steps
+}
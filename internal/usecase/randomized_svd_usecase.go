@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/lapack/lapack64"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// defaultOversampling es el número de columnas aleatorias que se añaden al rango objetivo.
+	defaultOversampling = 10
+	// defaultPowerIterations es el número de iteraciones de potencia por defecto.
+	defaultPowerIterations = 2
+	// maxPowerIterations limita power_iterations: cada iteración factoriza dos veces la matriz
+	// completa y más allá de unas pocas no mejora la aproximación en doble precisión.
+	maxPowerIterations = 20
+	// errorEstimationProbes es el número de vectores gaussianos con los que se acota la norma
+	// espectral del error; la cota falla con probabilidad 10^(−errorEstimationProbes).
+	errorEstimationProbes = 10
+)
+
+// ApproximateLowRank valida la matriz y las opciones y calcula una aproximación de rango k con
+// la SVD aleatorizada de Halko, Martinsson y Tropp. Su coste es O(m·n·(k + p)·(2q + 1)), frente
+// al O(m·n·min(m, n)) de la SVD completa.
+func (uc *matrixUsecase) ApproximateLowRank(matrix domain.Matrix, options domain.RandomizedSVDOptions) (domain.RandomizedSVD, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.RandomizedSVD{}, err
+	}
+	if maxRank := min(len(matrix), len(matrix[0])); options.Rank < 1 || options.Rank > maxRank {
+		return domain.RandomizedSVD{}, fmt.Errorf("%w: rank debe estar entre 1 y %d", domain.ErrInvalidLowRankOptions, maxRank)
+	}
+
+	oversampling, powerIterations := defaultOversampling, defaultPowerIterations
+	if options.Oversampling != nil {
+		oversampling = *options.Oversampling
+	}
+	if options.PowerIterations != nil {
+		powerIterations = *options.PowerIterations
+	}
+	if oversampling < 0 || powerIterations < 0 {
+		return domain.RandomizedSVD{}, fmt.Errorf("%w: oversampling y power_iterations no pueden ser negativos", domain.ErrInvalidLowRankOptions)
+	}
+	if powerIterations > maxPowerIterations {
+		return domain.RandomizedSVD{}, fmt.Errorf("%w: power_iterations no puede superar %d", domain.ErrInvalidLowRankOptions, maxPowerIterations)
+	}
+
+	seed := resolveSeed(options.Seed)
+	result, err := uc.randomizedSVD(matrix, options.Rank, oversampling, powerIterations, seed)
+	if err != nil {
+		return domain.RandomizedSVD{}, fmt.Errorf("error al calcular la SVD aleatorizada: %w", err)
+	}
+	return result, nil
+}
+
+// resolveSeed devuelve la semilla indicada o, si es nil, una al azar. Las semillas generadas
+// caben en 53 bits para que los clientes JSON las conserven exactas.
+func resolveSeed(seed *int64) int64 {
+	if seed != nil {
+		return *seed
+	}
+	return rand.Int64N(1 << 53)
+}
+
+// randomizedSVD sigue los pasos del algoritmo:
+//  1. Y = A·Ω con Ω gaussiana de n×l, l = min(k + p, m, n), y Q = orth(Y).
+//  2. q iteraciones de potencia Q = orth(A·orth(Aᵀ·Q)), que acentúan el decaimiento de los
+//     valores singulares reortogonalizando en cada paso.
+//  3. SVD de la matriz pequeña B = Qᵀ·A = Ũ·Σ·Vᵀ y U = Q·Ũ, truncadas a k.
+func (uc *matrixUsecase) randomizedSVD(matrix domain.Matrix, rank, oversampling, powerIterations int, seed int64) (domain.RandomizedSVD, error) {
+	a := toDense(matrix)
+	rows, cols := a.Dims()
+	// Se acota oversampling antes de sumarlo para que rank + oversampling no desborde; el
+	// número de columnas muestreadas no supera min(m, n) en ningún caso.
+	samples := min(rank+min(oversampling, rows, cols), rows, cols)
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+
+	var Y mat.Dense
+	Y.Mul(a, gaussianMatrix(rng, cols, samples))
+	Q := orthonormalBasis(&Y)
+	for i := 0; i < powerIterations; i++ {
+		var Z mat.Dense
+		Z.Mul(a.T(), Q)
+		Y.Mul(a, orthonormalBasis(&Z))
+		Q = orthonormalBasis(&Y)
+	}
+
+	var B mat.Dense
+	B.Mul(Q.T(), a)
+	var svd mat.SVD
+	if ok := svd.Factorize(&B, mat.SVDThin); !ok {
+		return domain.RandomizedSVD{}, errors.New("la descomposición SVD no convergió")
+	}
+	var smallU, V mat.Dense
+	svd.UTo(&smallU)
+	svd.VTo(&V)
+	sigma := svd.Values(nil)[:rank]
+
+	var U mat.Dense
+	U.Mul(Q, smallU.Slice(0, samples, 0, rank))
+	VT := V.Slice(0, cols, 0, rank).T()
+
+	// E = A − U·Σ·Vᵀ
+	scaled := mat.DenseCopyOf(&U)
+	for j, value := range sigma {
+		column := scaled.ColView(j).(*mat.VecDense)
+		column.ScaleVec(value, column)
+	}
+	var residual mat.Dense
+	residual.Mul(scaled, VT)
+	residual.Sub(a, &residual)
+
+	frobeniusError := mat.Norm(&residual, 2)
+	relativeError := 0.0
+	if norm := mat.Norm(a, 2); norm > 0 {
+		relativeError = frobeniusError / norm
+	}
+
+	return domain.RandomizedSVD{
+		U:                  fromDense(&U),
+		Sigma:              sigma,
+		VT:                 fromDense(VT),
+		Rank:               rank,
+		Oversampling:       oversampling,
+		PowerIterations:    powerIterations,
+		Seed:               seed,
+		FrobeniusError:     frobeniusError,
+		RelativeError:      relativeError,
+		SpectralErrorBound: spectralNormBound(rng, &residual),
+	}, nil
+}
+
+// spectralNormBound acota ‖E‖₂ con el estimador a posteriori de Halko, Martinsson y Tropp
+// (lema 4.1): ‖E‖₂ ≤ 10·√(2/π)·maxᵢ ‖E·ωᵢ‖₂ para vectores gaussianos ωᵢ, con probabilidad
+// al menos 1 − 10^(−r), siendo r el número de vectores.
+func spectralNormBound(rng *rand.Rand, e *mat.Dense) float64 {
+	_, cols := e.Dims()
+	var probes mat.Dense
+	probes.Mul(e, gaussianMatrix(rng, cols, errorEstimationProbes))
+
+	largest := 0.0
+	for j := 0; j < errorEstimationProbes; j++ {
+		largest = math.Max(largest, mat.Norm(probes.ColView(j), 2))
+	}
+	return 10 * math.Sqrt(2/math.Pi) * largest
+}
+
+// gaussianMatrix devuelve una matriz de rows×cols con elementos normales estándar independientes.
+func gaussianMatrix(rng *rand.Rand, rows, cols int) *mat.Dense {
+	data := make([]float64, rows*cols)
+	for i := range data {
+		data[i] = rng.NormFloat64()
+	}
+	return mat.NewDense(rows, cols, data)
+}
+
+// orthonormalBasis devuelve el factor Q reducido (m×l) de la factorización QR de y (m×l, m ≥ l)
+// mediante LAPACK Dgeqrf y Dorgqr, sin formar el Q completo de m×m.
+func orthonormalBasis(y *mat.Dense) *mat.Dense {
+	_, cols := y.Dims()
+	var q mat.Dense
+	q.CloneFrom(y)
+	raw := q.RawMatrix()
+
+	tau := make([]float64, cols)
+	work := []float64{0}
+	lapack64.Geqrf(raw, tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapack64.Geqrf(raw, tau, work, len(work))
+
+	work = []float64{0}
+	lapack64.Orgqr(raw, tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapack64.Orgqr(raw, tau, work, len(work))
+	return &q
+}
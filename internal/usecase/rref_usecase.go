@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ReduceRowEchelon valida la matriz y calcula su forma escalonada reducida por filas mediante
+// eliminación de Gauss–Jordan. tolerance es relativa a ‖A‖∞; si es 0 se usa max(m, n)·ε.
+func (uc *matrixUsecase) ReduceRowEchelon(matrix domain.Matrix, tolerance float64) (domain.ReducedRowEchelonForm, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.ReducedRowEchelonForm{}, err
+	}
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		return domain.ReducedRowEchelonForm{}, domain.ErrInvalidTolerance
+	}
+
+	return uc.reduceRowEchelon(matrix, tolerance), nil
+}
+
+// reduceRowEchelon recorre las columnas buscando en cada una, por debajo de los pivotes ya
+// fijados, el elemento de mayor valor absoluto (pivoteo parcial). Si no supera el umbral, la
+// columna es libre y sus elementos restantes se sustituyen por cero (un paso discard); si lo
+// supera, se sube su fila, se normaliza el pivote y se anula el resto de la columna. Solo se
+// registran las operaciones que modifican la matriz, de modo que el último paso coincide con
+// la forma escalonada devuelta.
+func (uc *matrixUsecase) reduceRowEchelon(matrix domain.Matrix, tolerance float64) domain.ReducedRowEchelonForm {
+	a := toDense(matrix)
+	rows, cols := a.Dims()
+	if tolerance == 0 {
+		tolerance = float64(max(rows, cols)) * machineEpsilon
+	}
+	threshold := tolerance * mat.Norm(a, math.Inf(1))
+
+	steps := make([]domain.RowOperation, 0)
+	record := func(kind domain.RowOperationKind, description string, target int, source *int, factor *float64) {
+		steps = append(steps, domain.RowOperation{
+			Kind:        kind,
+			Description: description,
+			Target:      target,
+			Source:      source,
+			Factor:      factor,
+			Matrix:      fromDense(a),
+		})
+	}
+
+	pivotColumns := make([]int, 0, min(rows, cols))
+	pivotRow := 0
+	for col := 0; col < cols && pivotRow < rows; col++ {
+		best := pivotRow
+		for r := pivotRow + 1; r < rows; r++ {
+			if math.Abs(a.At(r, col)) > math.Abs(a.At(best, col)) {
+				best = r
+			}
+		}
+		if math.Abs(a.At(best, col)) <= threshold {
+			if a.At(best, col) != 0 {
+				for r := pivotRow; r < rows; r++ {
+					a.Set(r, col, 0)
+				}
+				record(domain.RowOperationDiscard, fmt.Sprintf(domain.RowDiscardDescription, col+1, pivotRow+1, rows), pivotRow, nil, nil)
+			}
+			continue
+		}
+
+		if best != pivotRow {
+			rowA, rowB := mat.Row(nil, pivotRow, a), mat.Row(nil, best, a)
+			a.SetRow(pivotRow, rowB)
+			a.SetRow(best, rowA)
+			source := best
+			record(domain.RowOperationSwap, fmt.Sprintf(domain.RowSwapDescription, pivotRow+1, best+1), pivotRow, &source, nil)
+		}
+
+		if pivot := a.At(pivotRow, col); pivot != 1 {
+			row := a.RowView(pivotRow).(*mat.VecDense)
+			row.ScaleVec(1/pivot, row)
+			a.Set(pivotRow, col, 1)
+			factor := 1 / pivot
+			record(domain.RowOperationScale, fmt.Sprintf(domain.RowScaleDescription, pivotRow+1, formatFactor(pivot), col+1), pivotRow, nil, &factor)
+		}
+
+		pivotVector := a.RowView(pivotRow)
+		for r := 0; r < rows; r++ {
+			multiplier := a.At(r, col)
+			if r == pivotRow || multiplier == 0 {
+				continue
+			}
+			row := a.RowView(r).(*mat.VecDense)
+			row.AddScaledVec(row, -multiplier, pivotVector)
+			a.Set(r, col, 0)
+
+			sign := "−"
+			if multiplier < 0 {
+				sign = "+"
+			}
+			coefficient := ""
+			if math.Abs(multiplier) != 1 {
+				coefficient = formatFactor(math.Abs(multiplier)) + "·"
+			}
+			source, factor := pivotRow, -multiplier
+			record(domain.RowOperationReplacement, fmt.Sprintf(domain.RowReplacementDescription, r+1, sign, coefficient, pivotRow+1, col+1), r, &source, &factor)
+		}
+
+		pivotColumns = append(pivotColumns, col)
+		pivotRow++
+	}
+
+	return domain.ReducedRowEchelonForm{
+		RREF:         fromDense(a),
+		PivotColumns: pivotColumns,
+		Rank:         len(pivotColumns),
+		Tolerance:    tolerance,
+		Steps:        steps,
+	}
+}
+
+// formatFactor da formato a un coeficiente de una operación de fila con hasta seis cifras
+// significativas, sin ceros finales (4, 0.5, 0.333333).
+func formatFactor(value float64) string {
+	return strconv.FormatFloat(value, 'g', 6, 64)
+}
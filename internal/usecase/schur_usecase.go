@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ReduceHessenberg valida la matriz y calcula su forma de Hessenberg superior.
+func (uc *matrixUsecase) ReduceHessenberg(matrix domain.Matrix) (domain.HessenbergDecomposition, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return domain.HessenbergDecomposition{}, err
+	}
+
+	H, Q := hessenbergReduction(toDense(matrix))
+	return domain.HessenbergDecomposition{H: fromDense(H), Q: fromDense(Q)}, nil
+}
+
+// DecomposeSchur valida la matriz y calcula su descomposición real de Schur.
+func (uc *matrixUsecase) DecomposeSchur(matrix domain.Matrix) (domain.SchurDecomposition, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return domain.SchurDecomposition{}, err
+	}
+
+	schurDecomposition, err := uc.decomposeSchur(matrix)
+	if err != nil {
+		return domain.SchurDecomposition{}, fmt.Errorf("error al calcular la descomposición de Schur: %w", err)
+	}
+	return schurDecomposition, nil
+}
+
+// decomposeSchur reduce A a Hessenberg (A = Q·H·Qᵀ) y aplica el algoritmo QR de LAPACK
+// Dhseqr sobre H, acumulando las rotaciones en Q para obtener Z = Q·Z_H.
+func (uc *matrixUsecase) decomposeSchur(matrix domain.Matrix) (domain.SchurDecomposition, error) {
+	n := len(matrix)
+	T, Z := hessenbergReduction(toDense(matrix))
+
+	impl := gonum.Implementation{}
+	t, z := T.RawMatrix(), Z.RawMatrix()
+	wr := make([]float64, n)
+	wi := make([]float64, n)
+	work := []float64{0}
+	impl.Dhseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, n, 0, n-1, t.Data, t.Stride, wr, wi, z.Data, z.Stride, work, -1)
+	work = make([]float64, max(n, int(work[0])))
+	if unconverged := impl.Dhseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, n, 0, n-1, t.Data, t.Stride, wr, wi, z.Data, z.Stride, work, len(work)); unconverged > 0 {
+		return domain.SchurDecomposition{}, errors.New("el algoritmo QR no convergió para todos los valores propios")
+	}
+
+	eigenvalues := make([]domain.Complex, n)
+	for i := range eigenvalues {
+		eigenvalues[i] = domain.Complex{Real: wr[i], Imag: wi[i]}
+	}
+	return domain.SchurDecomposition{T: fromDense(T), Z: fromDense(Z), Eigenvalues: eigenvalues}, nil
+}
+
+// hessenbergReduction calcula A = Q·H·Qᵀ con LAPACK Dgehrd y Dorghr. H se devuelve con ceros
+// explícitos por debajo de la primera subdiagonal.
+func hessenbergReduction(a *mat.Dense) (H, Q *mat.Dense) {
+	n, _ := a.Dims()
+	impl := gonum.Implementation{}
+
+	H = mat.DenseCopyOf(a)
+	h := H.RawMatrix()
+	tau := make([]float64, max(0, n-1))
+	work := []float64{0}
+	impl.Dgehrd(n, 0, n-1, h.Data, h.Stride, tau, work, -1)
+	work = make([]float64, max(n, int(work[0])))
+	impl.Dgehrd(n, 0, n-1, h.Data, h.Stride, tau, work, len(work))
+
+	Q = mat.DenseCopyOf(H)
+	q := Q.RawMatrix()
+	work = []float64{0}
+	impl.Dorghr(n, 0, n-1, q.Data, q.Stride, tau, work, -1)
+	work = make([]float64, max(n, int(work[0])))
+	impl.Dorghr(n, 0, n-1, q.Data, q.Stride, tau, work, len(work))
+
+	for r := 2; r < n; r++ {
+		for c := 0; c < r-1; c++ {
+			H.Set(r, c, 0)
+		}
+	}
+	return H, Q
+}
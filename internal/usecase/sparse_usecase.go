@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"api-go/internal/domain"
+)
+
+// maxSparseDimension limita el orden de las matrices dispersas, que determina la memoria que
+// se reserva para rowPtr y para los vectores de los métodos iterativos.
+const maxSparseDimension = 1 << 22
+
+// csrMatrix almacena una matriz dispersa de n×n por filas comprimidas (CSR): los elementos no
+// nulos de la fila i ocupan las posiciones rowPtr[i]..rowPtr[i+1]−1 de colIdx y values,
+// ordenados por columna.
+type csrMatrix struct {
+	n      int
+	rowPtr []int
+	colIdx []int
+	values []float64
+}
+
+// csrFromDense convierte una matriz densa cuadrada a CSR descartando los ceros.
+func csrFromDense(matrix domain.Matrix) *csrMatrix {
+	n := len(matrix)
+	csr := &csrMatrix{n: n, rowPtr: make([]int, n+1)}
+	for i, row := range matrix {
+		for j, value := range row {
+			if value != 0 {
+				csr.colIdx = append(csr.colIdx, j)
+				csr.values = append(csr.values, value)
+			}
+		}
+		csr.rowPtr[i+1] = len(csr.values)
+	}
+	return csr
+}
+
+// csrFromSparse valida una matriz en formato COO, cuadrada, y la convierte a CSR sumando los
+// elementos repetidos y descartando los que resultan nulos.
+func csrFromSparse(sparse domain.SparseMatrix) (*csrMatrix, error) {
+	if sparse.Rows <= 0 || sparse.Cols <= 0 {
+		return nil, domain.ErrMatrixEmpty
+	}
+	if sparse.Rows != sparse.Cols {
+		return nil, domain.ErrMatrixNotSquare
+	}
+	if sparse.Rows > maxSparseDimension {
+		return nil, fmt.Errorf("%w: el orden máximo es %d", domain.ErrInvalidSparseMatrix, maxSparseDimension)
+	}
+	count := len(sparse.Values)
+	if len(sparse.RowIndices) != count || len(sparse.ColIndices) != count {
+		return nil, fmt.Errorf("%w: row_indices, col_indices y values deben tener la misma longitud", domain.ErrInvalidSparseMatrix)
+	}
+	n := sparse.Rows
+	for k := 0; k < count; k++ {
+		if sparse.RowIndices[k] < 0 || sparse.RowIndices[k] >= n || sparse.ColIndices[k] < 0 || sparse.ColIndices[k] >= n {
+			return nil, fmt.Errorf("%w: el elemento %d (%d, %d) está fuera de una matriz de %dx%d", domain.ErrInvalidSparseMatrix, k, sparse.RowIndices[k], sparse.ColIndices[k], n, n)
+		}
+		if math.IsNaN(sparse.Values[k]) || math.IsInf(sparse.Values[k], 0) {
+			return nil, fmt.Errorf("%w: el elemento %d no es finito", domain.ErrInvalidSparseMatrix, k)
+		}
+	}
+
+	order := make([]int, count)
+	for k := range order {
+		order[k] = k
+	}
+	sort.Slice(order, func(a, b int) bool {
+		ka, kb := order[a], order[b]
+		if sparse.RowIndices[ka] != sparse.RowIndices[kb] {
+			return sparse.RowIndices[ka] < sparse.RowIndices[kb]
+		}
+		return sparse.ColIndices[ka] < sparse.ColIndices[kb]
+	})
+
+	csr := &csrMatrix{n: n, rowPtr: make([]int, n+1)}
+	for start := 0; start < count; {
+		row, col := sparse.RowIndices[order[start]], sparse.ColIndices[order[start]]
+		sum := 0.0
+		end := start
+		for ; end < count && sparse.RowIndices[order[end]] == row && sparse.ColIndices[order[end]] == col; end++ {
+			sum += sparse.Values[order[end]]
+		}
+		if sum != 0 {
+			csr.colIdx = append(csr.colIdx, col)
+			csr.values = append(csr.values, sum)
+			csr.rowPtr[row+1]++
+		}
+		start = end
+	}
+	for i := 0; i < n; i++ {
+		csr.rowPtr[i+1] += csr.rowPtr[i]
+	}
+	return csr, nil
+}
+
+// mulVec calcula dst = A·x.
+func (a *csrMatrix) mulVec(dst, x []float64) {
+	for i := 0; i < a.n; i++ {
+		sum := 0.0
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			sum += a.values[k] * x[a.colIdx[k]]
+		}
+		dst[i] = sum
+	}
+}
+
+// at devuelve el elemento (i, j) mediante búsqueda binaria en la fila i.
+func (a *csrMatrix) at(i, j int) float64 {
+	start, end := a.rowPtr[i], a.rowPtr[i+1]
+	k := start + sort.SearchInts(a.colIdx[start:end], j)
+	if k < end && a.colIdx[k] == j {
+		return a.values[k]
+	}
+	return 0
+}
+
+// isSymmetric indica si A = Aᵀ con la misma tolerancia relativa que denseToSym.
+func (a *csrMatrix) isSymmetric() bool {
+	scale := 0.0
+	for _, value := range a.values {
+		scale = math.Max(scale, math.Abs(value))
+	}
+	for i := 0; i < a.n; i++ {
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			if math.Abs(a.values[k]-a.at(a.colIdx[k], i)) > symmetryTolerance*scale {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// preconditioner resuelve M·z = r para un precondicionador M ≈ A.
+type preconditioner interface {
+	apply(z, r []float64)
+}
+
+// newPreconditioner construye el precondicionador indicado para A.
+func newPreconditioner(kind domain.Preconditioner, a *csrMatrix) (preconditioner, error) {
+	switch kind {
+	case domain.PreconditionerJacobi:
+		return newJacobiPreconditioner(a)
+	case domain.PreconditionerILU0:
+		return newILU0Preconditioner(a)
+	default:
+		return identityPreconditioner{}, nil
+	}
+}
+
+// identityPreconditioner corresponde a M = I.
+type identityPreconditioner struct{}
+
+func (identityPreconditioner) apply(z, r []float64) {
+	copy(z, r)
+}
+
+// jacobiPreconditioner corresponde a M = diag(A).
+type jacobiPreconditioner struct {
+	inverseDiagonal []float64
+}
+
+func newJacobiPreconditioner(a *csrMatrix) (*jacobiPreconditioner, error) {
+	inverseDiagonal := make([]float64, a.n)
+	for i := range inverseDiagonal {
+		diagonal := a.at(i, i)
+		if diagonal == 0 {
+			return nil, fmt.Errorf("%w: el precondicionador de Jacobi requiere una diagonal sin ceros (fila %d)", domain.ErrInvalidSolverOptions, i+1)
+		}
+		inverseDiagonal[i] = 1 / diagonal
+	}
+	return &jacobiPreconditioner{inverseDiagonal: inverseDiagonal}, nil
+}
+
+func (p *jacobiPreconditioner) apply(z, r []float64) {
+	for i, value := range r {
+		z[i] = value * p.inverseDiagonal[i]
+	}
+}
+
+// ilu0Preconditioner corresponde a M = L·U, la factorización LU incompleta que conserva el
+// patrón de ceros de A. lu guarda L (diagonal unitaria implícita) y U sobre la estructura de A.
+type ilu0Preconditioner struct {
+	pattern  *csrMatrix
+	lu       []float64
+	diagonal []int // Posición del elemento diagonal de cada fila en lu
+}
+
+// newILU0Preconditioner aplica la eliminación gaussiana IKJ limitada al patrón de A: los
+// elementos que quedarían fuera del patrón (relleno) se descartan.
+func newILU0Preconditioner(a *csrMatrix) (*ilu0Preconditioner, error) {
+	lu := append([]float64(nil), a.values...)
+	diagonal := make([]int, a.n)
+	for i := 0; i < a.n; i++ {
+		diagonal[i] = -1
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			if a.colIdx[k] == i {
+				diagonal[i] = k
+			}
+		}
+		if diagonal[i] < 0 {
+			return nil, fmt.Errorf("%w: ILU(0) requiere una diagonal sin ceros (fila %d)", domain.ErrInvalidSolverOptions, i+1)
+		}
+	}
+
+	position := make([]int, a.n)
+	for j := range position {
+		position[j] = -1
+	}
+	for i := 0; i < a.n; i++ {
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			position[a.colIdx[k]] = k
+		}
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1] && a.colIdx[k] < i; k++ {
+			pivotRow := a.colIdx[k]
+			lu[k] /= lu[diagonal[pivotRow]]
+			for kk := diagonal[pivotRow] + 1; kk < a.rowPtr[pivotRow+1]; kk++ {
+				if target := position[a.colIdx[kk]]; target >= 0 {
+					lu[target] -= lu[k] * lu[kk]
+				}
+			}
+		}
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			position[a.colIdx[k]] = -1
+		}
+		if lu[diagonal[i]] == 0 {
+			return nil, fmt.Errorf("%w: ILU(0) encontró un pivote nulo en la fila %d", domain.ErrInvalidSolverOptions, i+1)
+		}
+	}
+	return &ilu0Preconditioner{pattern: a, lu: lu, diagonal: diagonal}, nil
+}
+
+// apply resuelve L·y = r por sustitución hacia delante y U·z = y hacia atrás.
+func (p *ilu0Preconditioner) apply(z, r []float64) {
+	a := p.pattern
+	for i := 0; i < a.n; i++ {
+		sum := r[i]
+		for k := a.rowPtr[i]; k < p.diagonal[i]; k++ {
+			sum -= p.lu[k] * z[a.colIdx[k]]
+		}
+		z[i] = sum
+	}
+	for i := a.n - 1; i >= 0; i-- {
+		sum := z[i]
+		for k := p.diagonal[i] + 1; k < a.rowPtr[i+1]; k++ {
+			sum -= p.lu[k] * z[a.colIdx[k]]
+		}
+		z[i] = sum / p.lu[p.diagonal[i]]
+	}
+}
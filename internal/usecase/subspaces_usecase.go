@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"api-go/internal/domain"
+	"gonum.org/v1/gonum/mat"
+)
+
+// FundamentalSubspaces valida la matriz y calcula bases ortonormales de sus espacios columna,
+// fila, nulo y nulo izquierdo. tolerance es relativa al mayor valor singular; si es 0 se usa
+// max(m, n)·ε.
+func (uc *matrixUsecase) FundamentalSubspaces(matrix domain.Matrix, tolerance float64) (domain.FundamentalSubspaces, error) {
+	if err := validateMatrix(matrix); err != nil {
+		return domain.FundamentalSubspaces{}, err
+	}
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		return domain.FundamentalSubspaces{}, domain.ErrInvalidTolerance
+	}
+
+	subspaces, err := uc.fundamentalSubspaces(matrix, tolerance)
+	if err != nil {
+		return domain.FundamentalSubspaces{}, fmt.Errorf("error al calcular los subespacios fundamentales: %w", err)
+	}
+	return subspaces, nil
+}
+
+// fundamentalSubspaces parte de la SVD completa A = U·Σ·Vᵀ con rango r: las primeras r
+// columnas de U y V generan los espacios columna y fila, y las restantes los espacios nulo
+// izquierdo y nulo.
+func (uc *matrixUsecase) fundamentalSubspaces(matrix domain.Matrix, tolerance float64) (domain.FundamentalSubspaces, error) {
+	a := toDense(matrix)
+	rows, cols := a.Dims()
+	if tolerance == 0 {
+		tolerance = float64(max(rows, cols)) * machineEpsilon
+	}
+
+	var svd mat.SVD
+	if ok := svd.Factorize(a, mat.SVDFull); !ok {
+		return domain.FundamentalSubspaces{}, errors.New("la descomposición SVD no convergió")
+	}
+	rank := rankFromSingularValues(svd.Values(nil), rows, cols, tolerance)
+
+	var U, V mat.Dense
+	svd.UTo(&U)
+	svd.VTo(&V)
+
+	return domain.FundamentalSubspaces{
+		Rank:          rank,
+		Tolerance:     tolerance,
+		ColumnSpace:   columnsAsRows(&U, 0, rank),
+		LeftNullSpace: columnsAsRows(&U, rank, rows),
+		RowSpace:      columnsAsRows(&V, 0, rank),
+		NullSpace:     columnsAsRows(&V, rank, cols),
+	}, nil
+}
+
+// columnsAsRows devuelve las columnas [from, to) de m como filas de una domain.Matrix. Un
+// rango vacío produce una matriz vacía, no nil, para que se serialice como [].
+func columnsAsRows(m *mat.Dense, from, to int) domain.Matrix {
+	if from == to {
+		return domain.Matrix{}
+	}
+	rows, _ := m.Dims()
+	return fromDense(m.Slice(0, rows, from, to).T())
+}
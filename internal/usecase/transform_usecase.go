@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"fmt"
+
+	"api-go/internal/domain"
+)
+
+// dihedralElement representa una simetría del rectángulo en la forma canónica x ↦ Rᵠ(Tᵗ(x)):
+// primero se traspone si transposed es true y después se gira quarterTurns veces 90° en
+// sentido horario. Las ocho combinaciones son los elementos del grupo diédrico de orden 8.
+type dihedralElement struct {
+	quarterTurns int
+	transposed   bool
+}
+
+// dihedralElements da la forma canónica de cada transformación admitida.
+var dihedralElements = map[domain.MatrixTransform]dihedralElement{
+	domain.TransformIdentity:       {0, false},
+	domain.TransformRotateCW90:     {1, false},
+	domain.TransformRotateCW180:    {2, false},
+	domain.TransformRotateCW270:    {3, false},
+	domain.TransformRotateCCW90:    {3, false},
+	domain.TransformRotateCCW180:   {2, false},
+	domain.TransformRotateCCW270:   {1, false},
+	domain.TransformTranspose:      {0, true},
+	domain.TransformFlipHorizontal: {1, true},
+	domain.TransformAntiTranspose:  {2, true},
+	domain.TransformFlipVertical:   {3, true},
+}
+
+// then devuelve el elemento que resulta de aplicar g y después h. Como T·R·T = R⁻¹, aplicar
+// h traspuesto invierte el sentido de los giros de g: Rᵃ·T·Rᵇ·T = Rᵃ⁻ᵇ.
+func (g dihedralElement) then(h dihedralElement) dihedralElement {
+	turns := g.quarterTurns
+	if h.transposed {
+		turns = -turns
+	}
+	return dihedralElement{
+		quarterTurns: ((h.quarterTurns+turns)%4 + 4) % 4,
+		transposed:   g.transposed != h.transposed,
+	}
+}
+
+// composeTransforms reduce una secuencia de transformaciones a un único elemento del grupo.
+// Una secuencia vacía (transform omitido o null) equivale al giro de 90° en sentido horario, el
+// comportamiento original del endpoint; en JSON no se admite una lista vacía.
+func composeTransforms(transforms []domain.MatrixTransform) (dihedralElement, error) {
+	if len(transforms) == 0 {
+		return dihedralElements[domain.TransformRotateCW90], nil
+	}
+	composed := dihedralElements[domain.TransformIdentity]
+	for _, transform := range transforms {
+		element, ok := dihedralElements[transform]
+		if !ok {
+			return dihedralElement{}, fmt.Errorf("%w: %q", domain.ErrUnsupportedTransform, transform)
+		}
+		composed = composed.then(element)
+	}
+	return composed, nil
+}
+
+// transformMatrix aplica g en una sola pasada: cada elemento se copia directamente a la
+// posición que ocupa tras la trasposición y los giros.
+func (uc *matrixUsecase) transformMatrix(matrix domain.Matrix, g dihedralElement) domain.Matrix {
+	rows := len(matrix)
+	cols := len(matrix[0])
+
+	outRows, outCols := rows, cols
+	if g.transposed != (g.quarterTurns%2 == 1) {
+		outRows, outCols = cols, rows
+	}
+	transformed := make(domain.Matrix, outRows)
+	for idx := range transformed {
+		transformed[idx] = make([]float64, outCols)
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			i, j, height, width := r, c, rows, cols
+			if g.transposed {
+				i, j, height, width = c, r, cols, rows
+			}
+			// Un giro horario lleva el elemento (i, j) de una matriz de height filas a (j, height − 1 − i).
+			for turn := 0; turn < g.quarterTurns; turn++ {
+				i, j = j, height-1-i
+				height, width = width, height
+			}
+			transformed[i][j] = matrix[r][c]
+		}
+	}
+	return transformed
+}
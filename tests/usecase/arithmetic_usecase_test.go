@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseComputeArithmetic(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	a := domain.Matrix{{1, 2}, {3, 4}}
+	b := domain.Matrix{{5, 6}, {7, 8}}
+	power := func(k int) *int { return &k }
+	rowVectors := make([]domain.Matrix, 70)
+	for i := range rowVectors {
+		rowVectors[i] = domain.Matrix{{1, 1}}
+	}
+
+	tests := []struct {
+		name          string
+		operator      domain.ArithmeticOperator
+		operands      []domain.Matrix
+		power         *int
+		expected      domain.Matrix
+		expectedError error
+	}{
+		{name: "Add three operands", operator: domain.ArithmeticAdd, operands: []domain.Matrix{a, b, a}, expected: domain.Matrix{{7, 10}, {13, 16}}},
+		{name: "Subtract", operator: domain.ArithmeticSubtract, operands: []domain.Matrix{b, a}, expected: domain.Matrix{{4, 4}, {4, 4}}},
+		{name: "Multiply", operator: domain.ArithmeticMultiply, operands: []domain.Matrix{a, b}, expected: domain.Matrix{{19, 22}, {43, 50}}},
+		{name: "Multiply rectangular chain", operator: domain.ArithmeticMultiply, operands: []domain.Matrix{{{1, 2, 3}}, {{1}, {1}, {1}}, {{2, 3}}}, expected: domain.Matrix{{12, 18}}},
+		{name: "Hadamard", operator: domain.ArithmeticHadamard, operands: []domain.Matrix{a, b}, expected: domain.Matrix{{5, 12}, {21, 32}}},
+		{
+			name:     "Kronecker",
+			operator: domain.ArithmeticKronecker,
+			operands: []domain.Matrix{{{1, 2}}, {{0, 1}, {1, 0}}},
+			expected: domain.Matrix{{0, 1, 0, 2}, {1, 0, 2, 0}},
+		},
+		{name: "Fibonacci power", operator: domain.ArithmeticPower, operands: []domain.Matrix{{{1, 1}, {1, 0}}}, power: power(10), expected: domain.Matrix{{89, 55}, {55, 34}}},
+		{name: "Power zero is identity", operator: domain.ArithmeticPower, operands: []domain.Matrix{a}, power: power(0), expected: domain.Matrix{{1, 0}, {0, 1}}},
+		{name: "Negative power uses inverse", operator: domain.ArithmeticPower, operands: []domain.Matrix{{{2, 0}, {0, 4}}}, power: power(-2), expected: domain.Matrix{{0.25, 0}, {0, 0.0625}}},
+		{name: "Add shape mismatch", operator: domain.ArithmeticAdd, operands: []domain.Matrix{a, {{1, 2, 3}}}, expectedError: domain.ErrDimensionMismatch},
+		{name: "Multiply inner dimension mismatch", operator: domain.ArithmeticMultiply, operands: []domain.Matrix{{{1, 2, 3}}, a}, expectedError: domain.ErrDimensionMismatch},
+		{name: "Non-rectangular operand", operator: domain.ArithmeticAdd, operands: []domain.Matrix{a, {{1, 2}, {3}}}, expectedError: domain.ErrMatrixNotRectangular},
+		{name: "Kronecker result too large", operator: domain.ArithmeticKronecker, operands: rowVectors, expectedError: domain.ErrInvalidOperands},
+		{name: "Single operand", operator: domain.ArithmeticMultiply, operands: []domain.Matrix{a}, expectedError: domain.ErrInvalidOperands},
+		{name: "Power out of range", operator: domain.ArithmeticPower, operands: []domain.Matrix{a}, power: power(math.MinInt), expectedError: domain.ErrInvalidOperands},
+		{name: "Power without exponent", operator: domain.ArithmeticPower, operands: []domain.Matrix{a}, expectedError: domain.ErrInvalidOperands},
+		{name: "Power of non-square matrix", operator: domain.ArithmeticPower, operands: []domain.Matrix{{{1, 2, 3}}}, power: power(2), expectedError: domain.ErrMatrixNotSquare},
+		{name: "Negative power of singular matrix", operator: domain.ArithmeticPower, operands: []domain.Matrix{{{1, 2}, {2, 4}}}, power: power(-1), expectedError: domain.ErrMatrixSingular},
+		{name: "Unknown operator", operator: "div", operands: []domain.Matrix{a, b}, expectedError: domain.ErrUnsupportedOperator},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.ComputeArithmetic(tt.operator, tt.operands, tt.power)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.operator, result.Operator)
+			assertMatrixInDelta(t, tt.expected, result.Result, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseEvaluateExpression(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	variables := map[string]domain.Matrix{
+		"A": {{1, 0}, {0, 2}, {1, 1}},
+		"b": {{1}, {2}, {3}},
+		"M": {{1, 2}, {3, 4}},
+		"S": {{1, 2}, {2, 4}},
+	}
+
+	tests := []struct {
+		name          string
+		expression    string
+		expected      domain.Matrix
+		expectedError error
+		position      string
+	}{
+		{name: "Scalar arithmetic precedence", expression: "1 + 2 * 3 ^ 2 - -4 / 2", expected: domain.Matrix{{21}}},
+		{name: "Power binds tighter than unary minus", expression: "-2^2", expected: domain.Matrix{{-4}}},
+		{name: "Normal equations", expression: "inv(A' * A) * A' * b", expected: domain.Matrix{{13.0 / 9}, {10.0 / 9}}},
+		{name: "Scalar times identity", expression: "M + 2*eye(2)", expected: domain.Matrix{{3, 2}, {3, 6}}},
+		{name: "Matrix power by squaring", expression: "[1, 1; 1, 0]^10", expected: domain.Matrix{{89, 55}, {55, 34}}},
+		{name: "Negative power", expression: "M^-1 * M", expected: domain.Matrix{{1, 0}, {0, 1}}},
+		{name: "Elementwise and broadcast", expression: "M .* M ./ 2 - 1", expected: domain.Matrix{{-0.5, 1}, {3.5, 7}}},
+		{name: "Scalar functions", expression: "det(M) + trace(M) + rank(S)", expected: domain.Matrix{{4}}},
+		{name: "Kronecker and shape helpers", expression: "kron(ones(1, 2), eye(2))", expected: domain.Matrix{{1, 0, 1, 0}, {0, 1, 0, 1}}},
+		{name: "Transpose of parenthesized product", expression: "(M * [1; 0])'", expected: domain.Matrix{{1, 3}}},
+		{name: "Dimension mismatch", expression: "A * A", expectedError: domain.ErrDimensionMismatch, position: "posición 3"},
+		{name: "Dimension mismatch in sum", expression: "M + A' ", expectedError: domain.ErrDimensionMismatch, position: "posición 3"},
+		{name: "Inverse of non-square", expression: "2 * inv(A)", expectedError: domain.ErrMatrixNotSquare, position: "posición 5"},
+		{name: "Inverse of singular matrix", expression: "inv(S)", expectedError: domain.ErrMatrixSingular, position: "posición 1"},
+		{name: "Missing closing parenthesis", expression: "(M + M", expectedError: domain.ErrExpressionSyntax, position: "posición 7"},
+		{name: "Unexpected character", expression: "M # M", expectedError: domain.ErrExpressionSyntax, position: "posición 3"},
+		{name: "Trailing operator", expression: "M +", expectedError: domain.ErrExpressionSyntax, position: "posición 4"},
+		{name: "Ragged literal", expression: "[1, 2; 3]", expectedError: domain.ErrDimensionMismatch, position: "posición 1"},
+		{name: "Non-literal eye dimension", expression: "eye(M)", expectedError: domain.ErrExpressionSyntax, position: "posición 5"},
+		{name: "Unknown variable", expression: "M + X", expectedError: domain.ErrUndefinedIdentifier, position: "posición 5"},
+		{name: "Unknown function", expression: "foo(M)", expectedError: domain.ErrUndefinedIdentifier, position: "posición 1"},
+		{name: "Huge constant", expression: "eye(2147483647)", expectedError: domain.ErrExpressionUndefined, position: "posición 1"},
+		{name: "Huge Kronecker product", expression: "kron(ones(1, 1024), ones(1, 2048))", expectedError: domain.ErrExpressionUndefined, position: "posición 1"},
+		{name: "Huge outer product", expression: "ones(2048, 1) * ones(1, 2048)", expectedError: domain.ErrExpressionUndefined, position: "posición 15"},
+		{name: "Division by zero", expression: "M / 0", expectedError: domain.ErrExpressionUndefined, position: "posición 3"},
+		{name: "Empty expression", expression: "  ", expectedError: domain.ErrExpressionSyntax},
+		{name: "Long flat sum", expression: "1" + strings.Repeat("+1", 4999), expected: domain.Matrix{{5000}}},
+		{name: "Nesting at the limit", expression: strings.Repeat("(", 99) + "M" + strings.Repeat(")", 99), expected: domain.Matrix{{1, 2}, {3, 4}}},
+		{name: "Expression too long", expression: strings.Repeat("1+", 500000) + "1", expectedError: domain.ErrExpressionSyntax, position: "posición 10001"},
+		{name: "Parentheses nested too deep", expression: strings.Repeat("(", 101) + "M" + strings.Repeat(")", 101), expectedError: domain.ErrExpressionSyntax, position: "posición 101"},
+		{name: "Signs nested too deep", expression: strings.Repeat("-", 200) + "M", expectedError: domain.ErrExpressionSyntax, position: "posición 101"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.EvaluateExpression(tt.expression, variables)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Contains(t, err.Error(), tt.position)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.expected), result.Rows)
+			assert.Equal(t, len(tt.expected[0]), result.Cols)
+			assertMatrixInDelta(t, tt.expected, result.Result, 1e-9)
+		})
+	}
+}
+
+func TestMatrixUsecaseEvaluateExpressionInvalidVariable(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	_, err := uc.EvaluateExpression("A", map[string]domain.Matrix{"A": {{1, 2}, {3}}})
+
+	assert.True(t, errors.Is(err, domain.ErrMatrixNotRectangular))
+}
@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+// assertGeneralizedEigenpairs comprueba que ‖A·v − λ·B·v‖ es despreciable para cada par finito.
+func assertGeneralizedEigenpairs(t *testing.T, a, b domain.Matrix, decomposition domain.GeneralizedEigenDecomposition) {
+	n := len(a)
+	for k, value := range decomposition.Values {
+		if value == nil {
+			continue
+		}
+		lambda := complex(value.Real, value.Imag)
+		for i := 0; i < n; i++ {
+			var residual complex128
+			for j := 0; j < n; j++ {
+				v := complex(decomposition.Vectors[j][k].Real, decomposition.Vectors[j][k].Imag)
+				residual += complex(a[i][j], 0)*v - lambda*complex(b[i][j], 0)*v
+			}
+			assert.Less(t, cmplx.Abs(residual), 1e-9, "residual of eigenpair %d, row %d", k, i)
+		}
+	}
+}
+
+func TestMatrixUsecaseDecomposeGeneralizedEigen(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name                      string
+		a                         domain.Matrix
+		b                         domain.Matrix
+		expectedSymmetricDefinite bool
+		expectedReal              []float64
+		expectedInfinite          int
+	}{
+		{
+			name:                      "Stiffness and mass matrices",
+			a:                         domain.Matrix{{2, -1}, {-1, 2}},
+			b:                         domain.Matrix{{1, 0}, {0, 2}},
+			expectedSymmetricDefinite: true,
+			expectedReal:              []float64{(3 - math.Sqrt(3)) / 2, (3 + math.Sqrt(3)) / 2},
+		},
+		{
+			name:                      "Identity B gives the standard problem",
+			a:                         domain.Matrix{{4, 1, 0}, {1, 3, 1}, {0, 1, 2}},
+			b:                         domain.Matrix{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+			expectedSymmetricDefinite: true,
+		},
+		{
+			name: "Nonsymmetric pencil with complex eigenvalues",
+			a:    domain.Matrix{{0, 1}, {-1, 0}},
+			b:    domain.Matrix{{2, 1}, {0, 1}},
+		},
+		{
+			name:             "Singular B gives an infinite eigenvalue",
+			a:                domain.Matrix{{1, 0}, {0, 2}},
+			b:                domain.Matrix{{1, 0}, {0, 0}},
+			expectedReal:     []float64{1},
+			expectedInfinite: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decomposition, err := uc.DecomposeGeneralizedEigen(tt.a, tt.b, true)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedSymmetricDefinite, decomposition.SymmetricDefinite)
+			assert.Len(t, decomposition.Values, len(tt.a))
+			assertGeneralizedEigenpairs(t, tt.a, tt.b, decomposition)
+
+			infinite := 0
+			var finite []float64
+			for _, value := range decomposition.Values {
+				if value == nil {
+					infinite++
+					continue
+				}
+				finite = append(finite, value.Real)
+			}
+			assert.Equal(t, tt.expectedInfinite, infinite)
+			if tt.expectedReal != nil {
+				assert.InDeltaSlice(t, tt.expectedReal, finite, 1e-9)
+			}
+
+			if tt.expectedSymmetricDefinite {
+				V := make(domain.Matrix, len(tt.a))
+				for i, row := range decomposition.Vectors {
+					V[i] = make([]float64, len(row))
+					for j, value := range row {
+						V[i][j] = value.Real
+					}
+				}
+				assertMatrixInDelta(t, diagonal(ones(len(tt.a)), len(tt.a), len(tt.a)), multiply(transpose(V), multiply(tt.b, V)), 1e-9)
+			}
+		})
+	}
+
+	t.Run("Values only", func(t *testing.T) {
+		decomposition, err := uc.DecomposeGeneralizedEigen(domain.Matrix{{2, 0}, {0, 3}}, domain.Matrix{{1, 0}, {0, 1}}, false)
+
+		assert.NoError(t, err)
+		assert.Nil(t, decomposition.Vectors)
+		assert.Len(t, decomposition.Values, 2)
+	})
+}
+
+func TestMatrixUsecaseDecomposeGeneralizedEigenErrors(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		a             domain.Matrix
+		b             domain.Matrix
+		expectedError error
+	}{
+		{
+			name:          "Different sizes",
+			a:             domain.Matrix{{1, 0}, {0, 1}},
+			b:             domain.Matrix{{1}},
+			expectedError: domain.ErrDimensionMismatch,
+		},
+		{
+			name:          "Non-square B",
+			a:             domain.Matrix{{1, 0}, {0, 1}},
+			b:             domain.Matrix{{1, 0, 0}, {0, 1, 0}},
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+		{
+			name:          "Singular pencil",
+			a:             domain.Matrix{{1, 0}, {0, 0}},
+			b:             domain.Matrix{{1, 0}, {0, 0}},
+			expectedError: domain.ErrMatrixSingular,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decomposition, err := uc.DecomposeGeneralizedEigen(tt.a, tt.b, true)
+
+			assert.Error(t, err)
+			assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+			assert.Equal(t, domain.GeneralizedEigenDecomposition{}, decomposition)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+// laplacian1D devuelve en formato COO la matriz tridiagonal tridiag(−1, 2, −1) de n×n.
+func laplacian1D(n int) *domain.SparseMatrix {
+	sparse := &domain.SparseMatrix{Rows: n, Cols: n}
+	for i := 0; i < n; i++ {
+		sparse.RowIndices = append(sparse.RowIndices, i)
+		sparse.ColIndices = append(sparse.ColIndices, i)
+		sparse.Values = append(sparse.Values, 2)
+		if i > 0 {
+			sparse.RowIndices = append(sparse.RowIndices, i, i-1)
+			sparse.ColIndices = append(sparse.ColIndices, i-1, i)
+			sparse.Values = append(sparse.Values, -1, -1)
+		}
+	}
+	return sparse
+}
+
+func multiplyVector(a domain.Matrix, x []float64) []float64 {
+	result := make([]float64, len(a))
+	for i, row := range a {
+		for j, value := range row {
+			result[i] += value * x[j]
+		}
+	}
+	return result
+}
+
+func TestMatrixUsecaseSolveIterative(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	nonsymmetric := domain.Matrix{{4, 1, 0, 0}, {2, 5, 1, 0}, {0, -1, 6, 2}, {1, 0, 3, 7}}
+	b := []float64{1, 2, 3, 4}
+	shortRestart, hugeRestart := 2, 1<<62
+
+	tests := []struct {
+		name    string
+		matrix  domain.Matrix
+		options domain.IterativeSolverOptions
+	}{
+		{name: "GMRES by default", matrix: nonsymmetric},
+		{name: "GMRES with a short restart", matrix: nonsymmetric, options: domain.IterativeSolverOptions{Restart: &shortRestart}},
+		{name: "GMRES restart larger than n is clamped", matrix: nonsymmetric, options: domain.IterativeSolverOptions{Restart: &hugeRestart}},
+		{name: "GMRES with Jacobi", matrix: nonsymmetric, options: domain.IterativeSolverOptions{Method: domain.IterativeMethodGMRES, Preconditioner: domain.PreconditionerJacobi}},
+		{name: "BiCGSTAB", matrix: nonsymmetric, options: domain.IterativeSolverOptions{Method: domain.IterativeMethodBiCGSTAB}},
+		{name: "BiCGSTAB with ILU(0)", matrix: nonsymmetric, options: domain.IterativeSolverOptions{Method: domain.IterativeMethodBiCGSTAB, Preconditioner: domain.PreconditionerILU0}},
+		{name: "Initial guess", matrix: nonsymmetric, options: domain.IterativeSolverOptions{InitialGuess: []float64{1, 1, 1, 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			solution, err := uc.SolveIterative(tt.matrix, nil, b, tt.options)
+
+			assert.NoError(t, err)
+			assert.True(t, solution.Converged)
+			assert.InDeltaSlice(t, b, multiplyVector(tt.matrix, solution.X), 1e-9)
+			assert.Less(t, solution.ResidualNorm, 1e-9)
+			assert.Len(t, solution.ResidualHistory, solution.Iterations+1)
+		})
+	}
+
+	t.Run("Zero right-hand side", func(t *testing.T) {
+		solution, err := uc.SolveIterative(nonsymmetric, nil, []float64{0, 0, 0, 0}, domain.IterativeSolverOptions{})
+
+		assert.NoError(t, err)
+		assert.True(t, solution.Converged)
+		assert.Equal(t, 0, solution.Iterations)
+		assert.Equal(t, []float64{0, 0, 0, 0}, solution.X)
+	})
+
+	t.Run("Iteration limit reports no convergence", func(t *testing.T) {
+		solution, err := uc.SolveIterative(nil, laplacian1D(50), constantVector(50, 1), domain.IterativeSolverOptions{Method: domain.IterativeMethodCG, MaxIterations: 3})
+
+		assert.NoError(t, err)
+		assert.False(t, solution.Converged)
+		assert.Equal(t, 3, solution.Iterations)
+		assert.Len(t, solution.ResidualHistory, 4)
+	})
+}
+
+func constantVector(n int, value float64) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = value
+	}
+	return values
+}
+
+func TestMatrixUsecaseSolveIterativeSparse(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	b := constantVector(50, 1)
+	longRestart := 50
+
+	tests := []struct {
+		name               string
+		options            domain.IterativeSolverOptions
+		expectedIterations int
+	}{
+		{name: "CG", options: domain.IterativeSolverOptions{Method: domain.IterativeMethodCG}},
+		{name: "CG with Jacobi", options: domain.IterativeSolverOptions{Method: domain.IterativeMethodCG, Preconditioner: domain.PreconditionerJacobi}},
+		{name: "ILU(0) of a tridiagonal matrix is exact", options: domain.IterativeSolverOptions{Method: domain.IterativeMethodCG, Preconditioner: domain.PreconditionerILU0}, expectedIterations: 1},
+		{name: "GMRES", options: domain.IterativeSolverOptions{Method: domain.IterativeMethodGMRES, Restart: &longRestart}},
+		{name: "BiCGSTAB", options: domain.IterativeSolverOptions{Method: domain.IterativeMethodBiCGSTAB}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			solution, err := uc.SolveIterative(nil, laplacian1D(50), b, tt.options)
+
+			assert.NoError(t, err)
+			assert.True(t, solution.Converged)
+			// La solución exacta de tridiag(−1, 2, −1)·x = 1 es xᵢ = (i + 1)·(n − i)/2.
+			for i, value := range solution.X {
+				assert.InDelta(t, float64((i+1)*(50-i))/2, value, 1e-6)
+			}
+			if tt.expectedIterations > 0 {
+				assert.Equal(t, tt.expectedIterations, solution.Iterations)
+			}
+		})
+	}
+
+	t.Run("Repeated entries are summed", func(t *testing.T) {
+		sparse := &domain.SparseMatrix{
+			Rows:       2,
+			Cols:       2,
+			RowIndices: []int{0, 0, 1, 1, 0},
+			ColIndices: []int{0, 1, 0, 1, 0},
+			Values:     []float64{1, 1, 1, 3, 1},
+		}
+		solution, err := uc.SolveIterative(nil, sparse, []float64{3, 4}, domain.IterativeSolverOptions{})
+
+		assert.NoError(t, err)
+		assert.InDeltaSlice(t, multiplyVector(domain.Matrix{{2, 1}, {1, 3}}, solution.X), []float64{3, 4}, 1e-9)
+	})
+}
+
+func TestMatrixUsecaseSolveIterativeErrors(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	spd := domain.Matrix{{2, -1}, {-1, 2}}
+	zeroRestart := 0
+
+	tests := []struct {
+		name          string
+		matrix        domain.Matrix
+		sparse        *domain.SparseMatrix
+		b             []float64
+		options       domain.IterativeSolverOptions
+		expectedError error
+	}{
+		{
+			name:          "Unknown method",
+			matrix:        spd,
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{Method: "jacobi"},
+			expectedError: domain.ErrUnsupportedMode,
+		},
+		{
+			name:          "Unknown preconditioner",
+			matrix:        spd,
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{Preconditioner: "ssor"},
+			expectedError: domain.ErrUnsupportedMode,
+		},
+		{
+			name:          "Negative tolerance",
+			matrix:        spd,
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{Tolerance: -1},
+			expectedError: domain.ErrInvalidTolerance,
+		},
+		{
+			name:          "Negative iteration limit",
+			matrix:        spd,
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{MaxIterations: -1},
+			expectedError: domain.ErrInvalidSolverOptions,
+		},
+		{
+			name:          "Iteration limit above the maximum",
+			matrix:        spd,
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{MaxIterations: 201},
+			expectedError: domain.ErrInvalidSolverOptions,
+		},
+		{
+			name:          "Zero restart",
+			matrix:        spd,
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{Restart: &zeroRestart},
+			expectedError: domain.ErrInvalidSolverOptions,
+		},
+		{
+			name:          "Declared sparse size larger than the right-hand side",
+			sparse:        &domain.SparseMatrix{Rows: 1 << 62, Cols: 1 << 62, RowIndices: []int{0}, ColIndices: []int{0}, Values: []float64{1}},
+			b:             []float64{1, 1},
+			expectedError: domain.ErrIncompatibleRightHandSide,
+		},
+		{
+			name:          "Right-hand side of the wrong length",
+			matrix:        spd,
+			b:             []float64{1, 1, 1},
+			expectedError: domain.ErrIncompatibleRightHandSide,
+		},
+		{
+			name:          "Non-square matrix",
+			matrix:        domain.Matrix{{1, 2, 3}, {4, 5, 6}},
+			b:             []float64{1, 1},
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+		{
+			name:          "Dense and sparse matrices at once",
+			matrix:        spd,
+			sparse:        laplacian1D(2),
+			b:             []float64{1, 1},
+			expectedError: domain.ErrInvalidSparseMatrix,
+		},
+		{
+			name:          "Sparse index out of range",
+			sparse:        &domain.SparseMatrix{Rows: 2, Cols: 2, RowIndices: []int{0, 2}, ColIndices: []int{0, 1}, Values: []float64{1, 1}},
+			b:             []float64{1, 1},
+			expectedError: domain.ErrInvalidSparseMatrix,
+		},
+		{
+			name:          "CG requires a symmetric matrix",
+			matrix:        domain.Matrix{{2, 1}, {0, 2}},
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{Method: domain.IterativeMethodCG},
+			expectedError: domain.ErrMatrixNotSymmetric,
+		},
+		{
+			name:          "CG requires a positive definite matrix",
+			matrix:        domain.Matrix{{1, 0}, {0, -1}},
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{Method: domain.IterativeMethodCG},
+			expectedError: domain.ErrMatrixNotPositiveDefinite,
+		},
+		{
+			name:          "Jacobi requires a nonzero diagonal",
+			matrix:        domain.Matrix{{0, 1}, {1, 0}},
+			b:             []float64{1, 1},
+			options:       domain.IterativeSolverOptions{Preconditioner: domain.PreconditionerJacobi},
+			expectedError: domain.ErrInvalidSolverOptions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			solution, err := uc.SolveIterative(tt.matrix, tt.sparse, tt.b, tt.options)
+
+			assert.Error(t, err)
+			assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+			assert.Equal(t, domain.IterativeSolution{}, solution)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseSolve(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name           string
+		coefficients   domain.Matrix
+		rightHandSides domain.Matrix
+		expectedMethod domain.SolverMethod
+		expectedX      domain.Matrix
+		expectedError  error
+	}{
+		{
+			name:           "Symmetric positive-definite system uses Cholesky",
+			coefficients:   domain.Matrix{{4, 1}, {1, 3}},
+			rightHandSides: domain.Matrix{{1, 2}, {5, 4}},
+			expectedMethod: domain.SolverMethodCholesky,
+			expectedX:      domain.Matrix{{1.0 / 11, 7.0 / 11}, {1, 1}},
+		},
+		{
+			name:           "General square system uses LU",
+			coefficients:   domain.Matrix{{0, 2, 1}, {4, 5, 6}, {7, 8, 10}},
+			rightHandSides: domain.Matrix{{3, 15, 25}},
+			expectedMethod: domain.SolverMethodLU,
+			expectedX:      domain.Matrix{{1, 1, 1}},
+		},
+		{
+			name:           "Overdetermined system uses least squares QR",
+			coefficients:   domain.Matrix{{1, 0}, {0, 1}, {1, 1}},
+			rightHandSides: domain.Matrix{{1, 1, 2}},
+			expectedMethod: domain.SolverMethodQR,
+			expectedX:      domain.Matrix{{1, 1}},
+		},
+		{
+			name:           "Underdetermined system returns the minimum-norm solution",
+			coefficients:   domain.Matrix{{1, 1}},
+			rightHandSides: domain.Matrix{{2}},
+			expectedMethod: domain.SolverMethodQR,
+			expectedX:      domain.Matrix{{1, 1}},
+		},
+		{
+			name:           "Singular system",
+			coefficients:   domain.Matrix{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			rightHandSides: domain.Matrix{{1, 2, 3}},
+			expectedError:  domain.ErrMatrixSingular,
+		},
+		{
+			name:           "Ill-conditioned system",
+			coefficients:   domain.Matrix{{1, 0}, {0, 1e-17}},
+			rightHandSides: domain.Matrix{{1, 1}},
+			expectedError:  domain.ErrMatrixIllConditioned,
+		},
+		{
+			name:           "Right-hand side with the wrong length",
+			coefficients:   domain.Matrix{{1, 0}, {0, 1}},
+			rightHandSides: domain.Matrix{{1, 2, 3}},
+			expectedError:  domain.ErrIncompatibleRightHandSide,
+		},
+		{
+			name:           "Empty right-hand side",
+			coefficients:   domain.Matrix{{1, 0}, {0, 1}},
+			rightHandSides: domain.Matrix{},
+			expectedError:  domain.ErrMatrixEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			solution, err := uc.Solve(tt.coefficients, tt.rightHandSides)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.LinearSystemSolution{}, solution)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMethod, solution.Method)
+			assertMatrixInDelta(t, tt.expectedX, solution.X, 1e-9)
+			assert.Len(t, solution.ResidualNorms, len(tt.rightHandSides))
+			assert.InDelta(t, 0, solution.ResidualNorm, 1e-9)
+		})
+	}
+}
+
+func TestMatrixUsecaseFitLeastSquares(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name                 string
+		inputMatrix          domain.Matrix
+		b                    []float64
+		tolerance            float64
+		expectedCoefficients []float64
+		expectedRSS          float64
+		expectedRank         int
+		expectedError        error
+	}{
+		{
+			name:                 "Line fit through noisy points",
+			inputMatrix:          domain.Matrix{{1, 0}, {1, 1}, {1, 2}, {1, 3}},
+			b:                    []float64{1, 3, 4, 4},
+			expectedCoefficients: []float64{1.5, 1},
+			expectedRSS:          1,
+			expectedRank:         2,
+		},
+		{
+			name:                 "Exact fit has zero residual",
+			inputMatrix:          domain.Matrix{{1, 1}, {1, 2}, {1, 3}},
+			b:                    []float64{3, 5, 7},
+			expectedCoefficients: []float64{1, 2},
+			expectedRank:         2,
+		},
+		{
+			name:                 "Collinear features give a basic solution",
+			inputMatrix:          domain.Matrix{{1, 2}, {2, 4}, {3, 6}},
+			b:                    []float64{2, 4, 6},
+			expectedCoefficients: []float64{0, 1},
+			expectedRank:         1,
+		},
+		{
+			name:          "Observation vector with the wrong length",
+			inputMatrix:   domain.Matrix{{1, 0}, {0, 1}},
+			b:             []float64{1},
+			expectedError: domain.ErrIncompatibleRightHandSide,
+		},
+		{
+			name:          "Negative tolerance",
+			inputMatrix:   domain.Matrix{{1, 0}, {0, 1}},
+			b:             []float64{1, 1},
+			tolerance:     -1,
+			expectedError: domain.ErrInvalidTolerance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fit, err := uc.FitLeastSquares(tt.inputMatrix, tt.b, tt.tolerance)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.LeastSquaresFit{}, fit)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRank, fit.Rank)
+			assert.InDeltaSlice(t, tt.expectedCoefficients, fit.Coefficients, 1e-9)
+			assert.InDelta(t, tt.expectedRSS, fit.ResidualSumOfSquares, 1e-9)
+			assert.Len(t, fit.Residuals, len(tt.b))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package usecase_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseEvaluateMatrixFunction(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	nonSymmetric := domain.Matrix{{4, 1}, {2, 3}} // Valores propios 2 y 5
+	exponent := func(p float64) *float64 { return &p }
+
+	tests := []struct {
+		name           string
+		inputMatrix    domain.Matrix
+		function       domain.MatrixFunctionName
+		power          *float64
+		t              float64
+		expectedResult domain.Matrix
+		assertResult   func(*testing.T, domain.Matrix)
+		expectedError  error
+	}{
+		{
+			name:           "Exponential of a nilpotent matrix scaled by t",
+			inputMatrix:    domain.Matrix{{0, 1}, {0, 0}},
+			function:       domain.MatrixFunctionExp,
+			t:              2,
+			expectedResult: domain.Matrix{{1, 2}, {0, 1}},
+		},
+		{
+			name:           "Exponential of a rotation generator",
+			inputMatrix:    domain.Matrix{{0, -math.Pi / 2}, {math.Pi / 2, 0}},
+			function:       domain.MatrixFunctionExp,
+			t:              1,
+			expectedResult: domain.Matrix{{0, -1}, {1, 0}},
+		},
+		{
+			name:           "Logarithm of a symmetric positive-definite matrix",
+			inputMatrix:    domain.Matrix{{math.E, 0}, {0, math.E * math.E}},
+			function:       domain.MatrixFunctionLog,
+			t:              1,
+			expectedResult: domain.Matrix{{1, 0}, {0, 2}},
+		},
+		{
+			name:        "Logarithm of a non-symmetric matrix inverts the exponential",
+			inputMatrix: nonSymmetric,
+			function:    domain.MatrixFunctionLog,
+			t:           1,
+			assertResult: func(t *testing.T, logarithm domain.Matrix) {
+				exp, err := uc.EvaluateMatrixFunction(logarithm, domain.MatrixFunctionExp, nil, 1)
+				assert.NoError(t, err)
+				assertMatrixInDelta(t, nonSymmetric, exp.Result, 1e-8)
+			},
+		},
+		{
+			name:        "Square root of a non-symmetric matrix",
+			inputMatrix: nonSymmetric,
+			function:    domain.MatrixFunctionSqrt,
+			t:           1,
+			assertResult: func(t *testing.T, root domain.Matrix) {
+				assertMatrixInDelta(t, nonSymmetric, multiply(root, root), 1e-9)
+			},
+		},
+		{
+			name:           "Square root of a singular symmetric matrix",
+			inputMatrix:    domain.Matrix{{4, 0}, {0, 0}},
+			function:       domain.MatrixFunctionSqrt,
+			t:              1,
+			expectedResult: domain.Matrix{{2, 0}, {0, 0}},
+		},
+		{
+			name:           "Negative integer power",
+			inputMatrix:    domain.Matrix{{2, 0}, {1, 1}},
+			function:       domain.MatrixFunctionPow,
+			power:          exponent(-2),
+			t:              1,
+			expectedResult: domain.Matrix{{0.25, 0}, {-0.75, 1}},
+		},
+		{
+			name:        "Fractional power of a non-symmetric matrix",
+			inputMatrix: nonSymmetric,
+			function:    domain.MatrixFunctionPow,
+			power:       exponent(1.5),
+			t:           1,
+			assertResult: func(t *testing.T, power domain.Matrix) {
+				root, err := uc.EvaluateMatrixFunction(nonSymmetric, domain.MatrixFunctionSqrt, nil, 1)
+				assert.NoError(t, err)
+				assertMatrixInDelta(t, multiply(nonSymmetric, root.Result), power, 1e-8)
+			},
+		},
+		{
+			name:          "Logarithm of a matrix with a negative eigenvalue",
+			inputMatrix:   domain.Matrix{{-1, 1}, {0, 2}},
+			function:      domain.MatrixFunctionLog,
+			t:             1,
+			expectedError: domain.ErrMatrixFunctionUndefined,
+		},
+		{
+			name:          "Square root of a negative-definite symmetric matrix",
+			inputMatrix:   domain.Matrix{{-1, 0}, {0, -4}},
+			function:      domain.MatrixFunctionSqrt,
+			t:             1,
+			expectedError: domain.ErrMatrixFunctionUndefined,
+		},
+		{
+			name:          "Negative power of a singular matrix",
+			inputMatrix:   domain.Matrix{{1, 2}, {2, 4}},
+			function:      domain.MatrixFunctionPow,
+			power:         exponent(-1),
+			t:             1,
+			expectedError: domain.ErrMatrixFunctionUndefined,
+		},
+		{
+			name:          "Power without exponent",
+			inputMatrix:   nonSymmetric,
+			function:      domain.MatrixFunctionPow,
+			t:             1,
+			expectedError: domain.ErrMatrixFunctionUndefined,
+		},
+		{
+			name:          "Unknown function",
+			inputMatrix:   nonSymmetric,
+			function:      "sin",
+			t:             1,
+			expectedError: domain.ErrUnsupportedFunction,
+		},
+		{
+			name:          "Non-square matrix",
+			inputMatrix:   domain.Matrix{{1, 2}},
+			function:      domain.MatrixFunctionExp,
+			t:             1,
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.EvaluateMatrixFunction(tt.inputMatrix, tt.function, tt.power, tt.t)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.MatrixFunctionResult{}, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.function, result.Function)
+			if tt.expectedResult != nil {
+				assertMatrixInDelta(t, tt.expectedResult, result.Result, 1e-9)
+			}
+			if tt.assertResult != nil {
+				tt.assertResult(t, result.Result)
+			}
+		})
+	}
+}
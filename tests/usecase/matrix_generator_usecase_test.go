@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseGenerateStructuredMatrix(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		kind          domain.MatrixKind
+		options       domain.MatrixGeneratorOptions
+		expected      domain.Matrix
+		expectedError error
+	}{
+		{name: "Identity", kind: domain.MatrixKindIdentity, options: domain.MatrixGeneratorOptions{Rows: 2, Cols: 3}, expected: domain.Matrix{{1, 0, 0}, {0, 1, 0}}},
+		{name: "Zeros", kind: domain.MatrixKindZeros, options: domain.MatrixGeneratorOptions{Rows: 1, Cols: 2}, expected: domain.Matrix{{0, 0}}},
+		{name: "Ones square by default", kind: domain.MatrixKindOnes, options: domain.MatrixGeneratorOptions{Rows: 2}, expected: domain.Matrix{{1, 1}, {1, 1}}},
+		{name: "Diagonal", kind: domain.MatrixKindDiagonal, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2}}, expected: domain.Matrix{{1, 0}, {0, 2}}},
+		{name: "Rectangular diagonal", kind: domain.MatrixKindDiagonal, options: domain.MatrixGeneratorOptions{Rows: 3, Cols: 2, Values: []float64{1, 2}}, expected: domain.Matrix{{1, 0}, {0, 2}, {0, 0}}},
+		{name: "Hilbert", kind: domain.MatrixKindHilbert, options: domain.MatrixGeneratorOptions{Rows: 2}, expected: domain.Matrix{{1, 0.5}, {0.5, 1.0 / 3}}},
+		{name: "Vandermonde", kind: domain.MatrixKindVandermonde, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2, 3}}, expected: domain.Matrix{{1, 1, 1}, {1, 2, 4}, {1, 3, 9}}},
+		{name: "Vandermonde with fewer powers", kind: domain.MatrixKindVandermonde, options: domain.MatrixGeneratorOptions{Cols: 2, Values: []float64{1, 2, 3}}, expected: domain.Matrix{{1, 1}, {1, 2}, {1, 3}}},
+		{name: "Symmetric Toeplitz", kind: domain.MatrixKindToeplitz, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2, 3}}, expected: domain.Matrix{{1, 2, 3}, {2, 1, 2}, {3, 2, 1}}},
+		{name: "Toeplitz with first row", kind: domain.MatrixKindToeplitz, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2, 3}, Row: []float64{1, 4}}, expected: domain.Matrix{{1, 4}, {2, 1}, {3, 2}}},
+		{name: "Hankel", kind: domain.MatrixKindHankel, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2, 3}}, expected: domain.Matrix{{1, 2, 3}, {2, 3, 0}, {3, 0, 0}}},
+		{name: "Hankel with last row", kind: domain.MatrixKindHankel, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2}, Row: []float64{2, 5, 6}}, expected: domain.Matrix{{1, 2, 5}, {2, 5, 6}}},
+		{name: "Circulant", kind: domain.MatrixKindCirculant, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2, 3}}, expected: domain.Matrix{{1, 3, 2}, {2, 1, 3}, {3, 2, 1}}},
+		{name: "Tridiagonal", kind: domain.MatrixKindTridiagonal, options: domain.MatrixGeneratorOptions{Rows: 3, Values: []float64{-1, 2, -3}}, expected: domain.Matrix{{2, -3, 0}, {-1, 2, -3}, {0, -1, 2}}},
+		{name: "Unknown kind", kind: "magic", options: domain.MatrixGeneratorOptions{Rows: 3}, expectedError: domain.ErrUnsupportedMatrixKind},
+		{name: "Missing size", kind: domain.MatrixKindIdentity, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Too many elements", kind: domain.MatrixKindZeros, options: domain.MatrixGeneratorOptions{Rows: 1 << 11, Cols: 1 << 10}, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Diagonal size mismatch", kind: domain.MatrixKindDiagonal, options: domain.MatrixGeneratorOptions{Rows: 3, Values: []float64{1, 2}}, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Vandermonde rows mismatch", kind: domain.MatrixKindVandermonde, options: domain.MatrixGeneratorOptions{Rows: 2, Values: []float64{1, 2, 3}}, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Vandermonde overflow", kind: domain.MatrixKindVandermonde, options: domain.MatrixGeneratorOptions{Cols: 400, Values: []float64{10}}, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Toeplitz corner mismatch", kind: domain.MatrixKindToeplitz, options: domain.MatrixGeneratorOptions{Values: []float64{1, 2}, Row: []float64{3, 4}}, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Empty circulant", kind: domain.MatrixKindCirculant, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Tridiagonal without three values", kind: domain.MatrixKindTridiagonal, options: domain.MatrixGeneratorOptions{Rows: 3, Values: []float64{2}}, expectedError: domain.ErrInvalidGeneratorOptions},
+		{name: "Non-square tridiagonal", kind: domain.MatrixKindTridiagonal, options: domain.MatrixGeneratorOptions{Rows: 3, Cols: 2, Values: []float64{-1, 2, -1}}, expectedError: domain.ErrMatrixNotSquare},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generated, err := uc.GenerateMatrix(tt.kind, tt.options)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.kind, generated.Kind)
+			assert.Nil(t, generated.Seed)
+			assertMatrixInDelta(t, tt.expected, generated.Matrix, 1e-12)
+		})
+	}
+}
+
+func TestMatrixUsecaseGenerateRandomMatrix(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	seed := int64(42)
+	low, high := -2.0, 3.0
+	zero := 0.0
+
+	t.Run("Same seed is deterministic", func(t *testing.T) {
+		for _, kind := range []domain.MatrixKind{domain.MatrixKindUniform, domain.MatrixKindNormal, domain.MatrixKindOrthogonal} {
+			options := domain.MatrixGeneratorOptions{Rows: 4, Cols: 3, Seed: &seed}
+			first, err := uc.GenerateMatrix(kind, options)
+			assert.NoError(t, err)
+			second, err := uc.GenerateMatrix(kind, options)
+			assert.NoError(t, err)
+			assert.Equal(t, first.Matrix, second.Matrix, string(kind))
+			assert.Equal(t, seed, *first.Seed)
+		}
+	})
+
+	t.Run("Random seed is returned", func(t *testing.T) {
+		generated, err := uc.GenerateMatrix(domain.MatrixKindNormal, domain.MatrixGeneratorOptions{Rows: 2})
+		assert.NoError(t, err)
+		assert.NotNil(t, generated.Seed)
+
+		replay, err := uc.GenerateMatrix(domain.MatrixKindNormal, domain.MatrixGeneratorOptions{Rows: 2, Seed: generated.Seed})
+		assert.NoError(t, err)
+		assert.Equal(t, generated.Matrix, replay.Matrix)
+	})
+
+	t.Run("Uniform respects bounds", func(t *testing.T) {
+		generated, err := uc.GenerateMatrix(domain.MatrixKindUniform, domain.MatrixGeneratorOptions{Rows: 20, Low: low, High: &high, Seed: &seed})
+		assert.NoError(t, err)
+		for _, row := range generated.Matrix {
+			for _, value := range row {
+				assert.True(t, value >= low && value < high)
+			}
+		}
+	})
+
+	t.Run("Normal with zero deviation is constant", func(t *testing.T) {
+		generated, err := uc.GenerateMatrix(domain.MatrixKindNormal, domain.MatrixGeneratorOptions{Rows: 2, Mean: 5, StdDev: &zero, Seed: &seed})
+		assert.NoError(t, err)
+		assertMatrixInDelta(t, domain.Matrix{{5, 5}, {5, 5}}, generated.Matrix, 0)
+	})
+
+	t.Run("Orthogonal has orthonormal columns or rows", func(t *testing.T) {
+		for _, shape := range [][2]int{{5, 5}, {5, 3}, {2, 4}} {
+			generated, err := uc.GenerateMatrix(domain.MatrixKindOrthogonal, domain.MatrixGeneratorOptions{Rows: shape[0], Cols: shape[1], Seed: &seed})
+			assert.NoError(t, err)
+
+			q := generated.Matrix
+			gram := multiply(transpose(q), q)
+			if shape[0] < shape[1] {
+				gram = multiply(q, transpose(q))
+			}
+			n := min(shape[0], shape[1])
+			assertMatrixInDelta(t, diagonal(ones(n), n, n), gram, 1e-12)
+		}
+	})
+
+	t.Run("Invalid uniform bounds", func(t *testing.T) {
+		_, err := uc.GenerateMatrix(domain.MatrixKindUniform, domain.MatrixGeneratorOptions{Rows: 2, Low: 3, High: &low})
+		assert.True(t, errors.Is(err, domain.ErrInvalidGeneratorOptions))
+	})
+
+	t.Run("Negative standard deviation", func(t *testing.T) {
+		negative := -1.0
+		_, err := uc.GenerateMatrix(domain.MatrixKindNormal, domain.MatrixGeneratorOptions{Rows: 2, StdDev: &negative})
+		assert.True(t, errors.Is(err, domain.ErrInvalidGeneratorOptions))
+	})
+}
@@ -0,0 +1,85 @@
+package usecase_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseAnalyzeMatrix(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	t.Run("Invertible square matrix", func(t *testing.T) {
+		report, err := uc.AnalyzeMatrix(domain.Matrix{{4, 7}, {2, 6}}, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Rank)
+		if assert.NotNil(t, report.Determinant) {
+			assert.InDelta(t, 10, *report.Determinant, 1e-9)
+		}
+		if assert.NotNil(t, report.LogDeterminant) {
+			assert.InDelta(t, math.Log(10), report.LogDeterminant.Value, 1e-9)
+			assert.Equal(t, 1.0, report.LogDeterminant.Sign)
+		}
+		if assert.NotNil(t, report.Trace) {
+			assert.Equal(t, 10.0, *report.Trace)
+		}
+		assertMatrixInDelta(t, domain.Matrix{{0.6, -0.7}, {-0.2, 0.4}}, report.Inverse, 1e-9)
+		assert.Equal(t, 11.0, report.Norms.Inf)
+		assert.Equal(t, 13.0, report.Norms.One)
+		assert.InDelta(t, math.Sqrt(105), report.Norms.Frobenius, 1e-9)
+		if assert.NotNil(t, report.ConditionNumber) {
+			assert.InDelta(t, report.Norms.Two*report.Norms.Two/10, *report.ConditionNumber, 1e-9)
+		}
+	})
+
+	t.Run("Singular square matrix", func(t *testing.T) {
+		report, err := uc.AnalyzeMatrix(domain.Matrix{{1, 2}, {2, 4}}, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Rank)
+		assert.Nil(t, report.Inverse)
+		assert.Nil(t, report.LogDeterminant)
+	})
+
+	t.Run("Large determinant is reported through its logarithm", func(t *testing.T) {
+		large := make(domain.Matrix, 200)
+		for i := range large {
+			large[i] = make([]float64, 200)
+			large[i][i] = 100
+		}
+		report, err := uc.AnalyzeMatrix(large, 0)
+
+		assert.NoError(t, err)
+		assert.Nil(t, report.Determinant)
+		if assert.NotNil(t, report.LogDeterminant) {
+			assert.InDelta(t, 200*math.Log(100), report.LogDeterminant.Value, 1e-9)
+		}
+	})
+
+	t.Run("Rectangular matrix omits square-only properties", func(t *testing.T) {
+		report, err := uc.AnalyzeMatrix(domain.Matrix{{1, 0, 0}, {0, 2, 0}}, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Rows)
+		assert.Equal(t, 3, report.Cols)
+		assert.Equal(t, 2, report.Rank)
+		assert.Equal(t, 2.0, report.Norms.Two)
+		assert.Nil(t, report.Trace)
+		assert.Nil(t, report.Determinant)
+		assert.Nil(t, report.Inverse)
+		if assert.NotNil(t, report.ConditionNumber) {
+			assert.InDelta(t, 2, *report.ConditionNumber, 1e-12)
+		}
+	})
+
+	t.Run("Negative tolerance", func(t *testing.T) {
+		_, err := uc.AnalyzeMatrix(domain.Matrix{{1}}, -1)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidTolerance))
+	})
+}
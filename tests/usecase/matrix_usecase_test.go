@@ -70,7 +70,7 @@ func TestMatrixUsecaseProcessMatrix(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rotated, qr, err := uc.ProcessMatrix(tt.inputMatrix)
+			rotated, qr, err := uc.ProcessMatrix(tt.inputMatrix, nil, domain.QROptions{})
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -86,4 +86,539 @@ func TestMatrixUsecaseProcessMatrix(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+// multiply devuelve el producto a·b de dos domain.Matrix compatibles.
+func multiply(a, b domain.Matrix) domain.Matrix {
+	result := make(domain.Matrix, len(a))
+	for i := range a {
+		result[i] = make([]float64, len(b[0]))
+		for j := range b[0] {
+			for k := range b {
+				result[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return result
+}
+
+// assertMatrixInDelta comprueba que dos matrices tengan la misma forma y elementos cercanos.
+func assertMatrixInDelta(t *testing.T, expected, actual domain.Matrix, delta float64) {
+	t.Helper()
+	if !assert.Len(t, actual, len(expected)) {
+		return
+	}
+	for i := range expected {
+		if !assert.Len(t, actual[i], len(expected[i])) {
+			return
+		}
+		for j := range expected[i] {
+			assert.InDelta(t, expected[i][j], actual[i][j], delta, "elemento (%d, %d)", i, j)
+		}
+	}
+}
+
+func TestMatrixUsecaseFactorizeLU(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		expectedError error
+	}{
+		{
+			name:        "Valid 3x3 matrix requiring pivoting",
+			inputMatrix: domain.Matrix{{0, 2, 1}, {4, 5, 6}, {7, 8, 10}},
+		},
+		{
+			name:          "Singular matrix",
+			inputMatrix:   domain.Matrix{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			expectedError: domain.ErrMatrixSingular,
+		},
+		{
+			name:          "Non-square matrix",
+			inputMatrix:   domain.Matrix{{1, 2, 3}, {4, 5, 6}},
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+		{
+			name:          "Empty matrix",
+			inputMatrix:   domain.Matrix{},
+			expectedError: domain.ErrMatrixEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lu, err := uc.FactorizeLU(tt.inputMatrix)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.LUFactorization{}, lu)
+				return
+			}
+
+			assert.NoError(t, err)
+			assertMatrixInDelta(t, tt.inputMatrix, multiply(multiply(lu.P, lu.L), lu.U), 1e-9)
+			for i := range lu.L {
+				assert.Equal(t, 1.0, lu.L[i][i])
+				for j := i + 1; j < len(lu.L); j++ {
+					assert.Zero(t, lu.L[i][j])
+				}
+				for j := 0; j < i; j++ {
+					assert.Zero(t, lu.U[i][j])
+				}
+			}
+		})
+	}
+}
+
+// transpose devuelve la traspuesta de una domain.Matrix.
+func transpose(m domain.Matrix) domain.Matrix {
+	result := make(domain.Matrix, len(m[0]))
+	for j := range result {
+		result[j] = make([]float64, len(m))
+		for i := range m {
+			result[j][i] = m[i][j]
+		}
+	}
+	return result
+}
+
+func TestMatrixUsecaseFactorizeCholesky(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		expectedL     domain.Matrix
+		expectedError error
+	}{
+		{
+			name:        "Valid covariance matrix",
+			inputMatrix: domain.Matrix{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}},
+			expectedL:   domain.Matrix{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}},
+		},
+		{
+			name:          "Non-symmetric matrix",
+			inputMatrix:   domain.Matrix{{4, 1}, {2, 3}},
+			expectedError: domain.ErrMatrixNotSymmetric,
+		},
+		{
+			name:          "Symmetric indefinite matrix",
+			inputMatrix:   domain.Matrix{{1, 2}, {2, 1}},
+			expectedError: domain.ErrMatrixNotPositiveDefinite,
+		},
+		{
+			name:          "Non-square matrix",
+			inputMatrix:   domain.Matrix{{1, 2, 3}},
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chol, err := uc.FactorizeCholesky(tt.inputMatrix)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.CholeskyFactorization{}, chol)
+				return
+			}
+
+			assert.NoError(t, err)
+			assertMatrixInDelta(t, tt.expectedL, chol.L, 1e-9)
+			assertMatrixInDelta(t, tt.inputMatrix, multiply(chol.L, transpose(chol.L)), 1e-9)
+		})
+	}
+}
+
+// diagonal construye una matriz rows×cols con values en la diagonal principal.
+func diagonal(values []float64, rows, cols int) domain.Matrix {
+	result := make(domain.Matrix, rows)
+	for i := range result {
+		result[i] = make([]float64, cols)
+		if i < cols && i < len(values) {
+			result[i][i] = values[i]
+		}
+	}
+	return result
+}
+
+func TestMatrixUsecaseDecomposeSVD(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	wide := domain.Matrix{{3, 2, 2}, {2, 3, -2}}
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		mode          domain.SVDMode
+		expectedUDims [2]int
+		expectedVDims [2]int
+		expectedError error
+	}{
+		{
+			name:          "Thin SVD of wide matrix",
+			inputMatrix:   wide,
+			mode:          domain.SVDModeThin,
+			expectedUDims: [2]int{2, 2},
+			expectedVDims: [2]int{2, 3},
+		},
+		{
+			name:          "Default mode is thin",
+			inputMatrix:   wide,
+			expectedUDims: [2]int{2, 2},
+			expectedVDims: [2]int{2, 3},
+		},
+		{
+			name:          "Full SVD of tall matrix",
+			inputMatrix:   transpose(wide),
+			mode:          domain.SVDModeFull,
+			expectedUDims: [2]int{3, 3},
+			expectedVDims: [2]int{2, 2},
+		},
+		{
+			name:        "Values only",
+			inputMatrix: wide,
+			mode:        domain.SVDModeValues,
+		},
+		{
+			name:          "Unknown mode",
+			inputMatrix:   wide,
+			mode:          "compact",
+			expectedError: domain.ErrUnsupportedMode,
+		},
+		{
+			name:          "Non-rectangular matrix",
+			inputMatrix:   domain.Matrix{{1, 2}, {3}},
+			expectedError: domain.ErrMatrixNotRectangular,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svd, err := uc.DecomposeSVD(tt.inputMatrix, tt.mode)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.SVDFactorization{}, svd)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.InDeltaSlice(t, []float64{5, 3}, svd.Sigma, 1e-9)
+			if tt.mode == domain.SVDModeValues {
+				assert.Nil(t, svd.U)
+				assert.Nil(t, svd.VT)
+				return
+			}
+
+			assert.Len(t, svd.U, tt.expectedUDims[0])
+			assert.Len(t, svd.U[0], tt.expectedUDims[1])
+			assert.Len(t, svd.VT, tt.expectedVDims[0])
+			assert.Len(t, svd.VT[0], tt.expectedVDims[1])
+			sigma := diagonal(svd.Sigma, len(svd.U[0]), len(svd.VT))
+			assertMatrixInDelta(t, tt.inputMatrix, multiply(multiply(svd.U, sigma), svd.VT), 1e-9)
+		})
+	}
+}
+
+func TestMatrixUsecaseDecomposeEigen(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name              string
+		inputMatrix       domain.Matrix
+		leftVectors       bool
+		rightVectors      bool
+		expectedSymmetric bool
+		expectedValues    []complex128
+		expectedError     error
+	}{
+		{
+			name:              "Symmetric matrix uses EigenSym",
+			inputMatrix:       domain.Matrix{{2, 1}, {1, 2}},
+			rightVectors:      true,
+			expectedSymmetric: true,
+			expectedValues:    []complex128{1, 3},
+		},
+		{
+			name:           "Rotation matrix has complex eigenvalues",
+			inputMatrix:    domain.Matrix{{0, -1}, {1, 0}},
+			leftVectors:    true,
+			rightVectors:   true,
+			expectedValues: []complex128{complex(0, 1), complex(0, -1)},
+		},
+		{
+			name:           "Values only",
+			inputMatrix:    domain.Matrix{{1, 2}, {0, 3}},
+			expectedValues: []complex128{1, 3},
+		},
+		{
+			name:          "Non-square matrix",
+			inputMatrix:   domain.Matrix{{1, 2}},
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eigen, err := uc.DecomposeEigen(tt.inputMatrix, tt.leftVectors, tt.rightVectors)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.EigenDecomposition{}, eigen)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedSymmetric, eigen.Symmetric)
+			if !assert.Len(t, eigen.Values, len(tt.expectedValues)) {
+				return
+			}
+			for i, expected := range tt.expectedValues {
+				assert.InDelta(t, real(expected), eigen.Values[i].Real, 1e-9)
+				assert.InDelta(t, imag(expected), eigen.Values[i].Imag, 1e-9)
+			}
+			assert.Equal(t, tt.leftVectors, eigen.LeftVectors != nil)
+			assert.Equal(t, tt.rightVectors, eigen.RightVectors != nil)
+
+			// Cada columna x de los vectores derechos debe cumplir A·x = λ·x.
+			for j := range eigen.RightVectors {
+				lambda := complex(eigen.Values[j].Real, eigen.Values[j].Imag)
+				for i, row := range tt.inputMatrix {
+					var ax complex128
+					for k, a := range row {
+						ax += complex(a, 0) * complex(eigen.RightVectors[k][j].Real, eigen.RightVectors[k][j].Imag)
+					}
+					x := complex(eigen.RightVectors[i][j].Real, eigen.RightVectors[i][j].Imag)
+					assert.InDelta(t, real(lambda*x), real(ax), 1e-9)
+					assert.InDelta(t, imag(lambda*x), imag(ax), 1e-9)
+				}
+			}
+		})
+	}
+}
+
+func TestMatrixUsecaseProcessMatrixQRModes(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	tall := domain.Matrix{{1, 2}, {3, 4}, {5, 6}}
+	wide := domain.Matrix{{1, 2, 3, 4}, {5, 6, 7, 9}}
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		mode          domain.QRMode
+		expectedQDims [2]int
+		expectedRDims [2]int
+		expectedError error
+	}{
+		{
+			name:          "Economy QR of tall matrix",
+			inputMatrix:   tall,
+			mode:          domain.QRModeEconomy,
+			expectedQDims: [2]int{3, 2},
+			expectedRDims: [2]int{2, 2},
+		},
+		{
+			name:          "Full QR of tall matrix",
+			inputMatrix:   tall,
+			mode:          domain.QRModeFull,
+			expectedQDims: [2]int{3, 3},
+			expectedRDims: [2]int{3, 2},
+		},
+		{
+			name:          "R-only QR of tall matrix",
+			inputMatrix:   tall,
+			mode:          domain.QRModeROnly,
+			expectedRDims: [2]int{2, 2},
+		},
+		{
+			name:          "Economy QR of wide matrix",
+			inputMatrix:   wide,
+			mode:          domain.QRModeEconomy,
+			expectedQDims: [2]int{2, 2},
+			expectedRDims: [2]int{2, 4},
+		},
+		{
+			name:          "Full QR of wide matrix",
+			inputMatrix:   wide,
+			mode:          domain.QRModeFull,
+			expectedQDims: [2]int{2, 2},
+			expectedRDims: [2]int{2, 4},
+		},
+		{
+			name:          "Unknown mode",
+			inputMatrix:   tall,
+			mode:          "reduced",
+			expectedError: domain.ErrUnsupportedMode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, qr, err := uc.ProcessMatrix(tt.inputMatrix, nil, domain.QROptions{Mode: tt.mode})
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, qr.R, tt.expectedRDims[0])
+			assert.Len(t, qr.R[0], tt.expectedRDims[1])
+			for i := range qr.R {
+				for j := 0; j < i && j < len(qr.R[i]); j++ {
+					assert.Zero(t, qr.R[i][j])
+				}
+			}
+			if tt.mode == domain.QRModeROnly {
+				assert.Nil(t, qr.Q)
+				return
+			}
+
+			assert.Len(t, qr.Q, tt.expectedQDims[0])
+			assert.Len(t, qr.Q[0], tt.expectedQDims[1])
+			assertMatrixInDelta(t, tt.inputMatrix, multiply(qr.Q, qr.R), 1e-9)
+			assertMatrixInDelta(t, diagonal([]float64{1, 1, 1}, tt.expectedQDims[1], tt.expectedQDims[1]), multiply(transpose(qr.Q), qr.Q), 1e-9)
+		})
+	}
+}
+
+// permuteColumns devuelve A·P, donde la columna j del resultado es la columna permutation[j] de a.
+func permuteColumns(a domain.Matrix, permutation []int) domain.Matrix {
+	result := make(domain.Matrix, len(a))
+	for i := range a {
+		result[i] = make([]float64, len(permutation))
+		for j, source := range permutation {
+			result[i][j] = a[i][source]
+		}
+	}
+	return result
+}
+
+func TestMatrixUsecaseProcessMatrixPivotedQR(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		options       domain.QROptions
+		expectedRank  int
+		expectedError error
+	}{
+		{
+			name:         "Collinear columns reduce the rank",
+			inputMatrix:  domain.Matrix{{1, 2, 3}, {2, 4, 1}, {3, 6, 2}, {4, 8, 5}},
+			options:      domain.QROptions{Pivoting: true},
+			expectedRank: 2,
+		},
+		{
+			name:         "Full-rank wide matrix",
+			inputMatrix:  domain.Matrix{{1, 0, 2, 1}, {0, 1, 1, 3}},
+			options:      domain.QROptions{Pivoting: true, Mode: domain.QRModeFull},
+			expectedRank: 2,
+		},
+		{
+			name:         "Loose tolerance treats small pivots as zero",
+			inputMatrix:  domain.Matrix{{1, 0}, {0, 1e-6}},
+			options:      domain.QROptions{Pivoting: true, Tolerance: 1e-3},
+			expectedRank: 1,
+		},
+		{
+			name:          "Negative tolerance",
+			inputMatrix:   domain.Matrix{{1, 0}, {0, 1}},
+			options:       domain.QROptions{Pivoting: true, Tolerance: -1},
+			expectedError: domain.ErrInvalidTolerance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, qr, err := uc.ProcessMatrix(tt.inputMatrix, nil, tt.options)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, qr.Rank) {
+				assert.Equal(t, tt.expectedRank, *qr.Rank)
+			}
+			assert.ElementsMatch(t, []int{0, 1, 2, 3}[:len(tt.inputMatrix[0])], qr.Permutation)
+			assertMatrixInDelta(t, permuteColumns(tt.inputMatrix, qr.Permutation), multiply(qr.Q, qr.R), 1e-9)
+		})
+	}
+}
+
+func TestMatrixUsecaseProcessMatrixQRDiagnostics(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name              string
+		inputMatrix       domain.Matrix
+		options           domain.QROptions
+		expectedCondition bool
+	}{
+		{
+			name:              "Normalized economy QR with diagnostics",
+			inputMatrix:       domain.Matrix{{1, 2}, {3, 4}, {5, 6}},
+			options:           domain.QROptions{Diagnostics: true, Normalize: true},
+			expectedCondition: true,
+		},
+		{
+			name:              "Normalized pivoted QR of wide matrix",
+			inputMatrix:       domain.Matrix{{2, -1, 0, 4}, {-3, 5, 1, 1}},
+			options:           domain.QROptions{Diagnostics: true, Normalize: true, Pivoting: true},
+			expectedCondition: true,
+		},
+		{
+			name:        "Exactly singular R omits the condition estimate",
+			inputMatrix: domain.Matrix{{1, 2}, {0, 0}},
+			options:     domain.QROptions{Diagnostics: true, Normalize: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, qr, err := uc.ProcessMatrix(tt.inputMatrix, nil, tt.options)
+
+			assert.NoError(t, err)
+			for i := 0; i < len(qr.R) && i < len(qr.R[i]); i++ {
+				assert.GreaterOrEqual(t, qr.R[i][i], 0.0)
+			}
+			target := tt.inputMatrix
+			if qr.Permutation != nil {
+				target = permuteColumns(tt.inputMatrix, qr.Permutation)
+			}
+			assertMatrixInDelta(t, target, multiply(qr.Q, qr.R), 1e-9)
+
+			if !assert.NotNil(t, qr.Diagnostics) {
+				return
+			}
+			assert.Less(t, qr.Diagnostics.ReconstructionResidual, 1e-12)
+			assert.Less(t, qr.Diagnostics.OrthogonalityError, 1e-12)
+			if tt.expectedCondition {
+				if assert.NotNil(t, qr.Diagnostics.ConditionEstimate) {
+					assert.GreaterOrEqual(t, *qr.Diagnostics.ConditionEstimate, 1.0)
+				}
+			} else {
+				assert.Nil(t, qr.Diagnostics.ConditionEstimate)
+			}
+		})
+	}
+
+	t.Run("Normalization makes the factorization unique", func(t *testing.T) {
+		_, qr, err := uc.ProcessMatrix(domain.Matrix{{3, 0}, {4, 5}}, nil, domain.QROptions{Normalize: true})
+
+		assert.NoError(t, err)
+		assertMatrixInDelta(t, domain.Matrix{{0.6, -0.8}, {0.8, 0.6}}, qr.Q, 1e-12)
+		assertMatrixInDelta(t, domain.Matrix{{5, 4}, {0, 3}}, qr.R, 1e-12)
+	})
+}
@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseRunPipeline(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	input := domain.Matrix{{1, 2, 3}, {4, 5, 6}}
+	turns := func(k int) *int { return &k }
+	tooManySteps := make([]domain.PipelineStep, 65)
+	for i := range tooManySteps {
+		tooManySteps[i] = domain.PipelineStep{Op: "transpose"}
+	}
+
+	tests := []struct {
+		name          string
+		matrix        domain.Matrix
+		steps         []domain.PipelineStep
+		expected      domain.Matrix
+		expectedError error
+	}{
+		{
+			name:     "Transpose then rotate twice",
+			matrix:   input,
+			steps:    []domain.PipelineStep{{Op: "transpose"}, {Op: "rotate", K: turns(2)}},
+			expected: domain.Matrix{{6, 3}, {5, 2}, {4, 1}},
+		},
+		{
+			name:     "Negative rotation is counter-clockwise",
+			matrix:   input,
+			steps:    []domain.PipelineStep{{Op: "rotate", K: turns(-1)}},
+			expected: domain.Matrix{{3, 6}, {2, 5}, {1, 4}},
+		},
+		{
+			name:     "Transform names are operations",
+			matrix:   input,
+			steps:    []domain.PipelineStep{{Op: "flip-horizontal"}, {Op: "transform", Transform: domain.MatrixTransforms{domain.TransformFlipVertical}}},
+			expected: domain.Matrix{{6, 5, 4}, {3, 2, 1}},
+		},
+		{
+			name:     "Take a factor to continue",
+			matrix:   domain.Matrix{{4, 2}, {2, 3}},
+			steps:    []domain.PipelineStep{{Op: "cholesky", Take: "L"}, {Op: "transpose"}},
+			expected: domain.Matrix{{2, 1}, {0, 1.4142135623730951}},
+		},
+		{
+			name:     "Matrix results chain without take",
+			matrix:   domain.Matrix{{2, 0}, {0, 4}},
+			steps:    []domain.PipelineStep{{Op: "pseudoinverse"}, {Op: "rref"}, {Op: "analyze", Take: "inverse"}},
+			expected: domain.Matrix{{1, 0}, {0, 1}},
+		},
+		{
+			name:     "Rotate defaults to one clockwise quarter turn",
+			matrix:   input,
+			steps:    []domain.PipelineStep{{Op: "rotate"}},
+			expected: domain.Matrix{{4, 1}, {5, 2}, {6, 3}},
+		},
+		{name: "Too many steps", matrix: input, steps: tooManySteps, expectedError: domain.ErrInvalidPipeline},
+		{name: "Empty pipeline", matrix: input, expectedError: domain.ErrInvalidPipeline},
+		{name: "Unknown operation", matrix: input, steps: []domain.PipelineStep{{Op: "invert"}}, expectedError: domain.ErrInvalidPipeline},
+		{
+			name:          "Factorization without take before the last step",
+			matrix:        input,
+			steps:         []domain.PipelineStep{{Op: "qr"}, {Op: "transpose"}},
+			expectedError: domain.ErrInvalidPipeline,
+		},
+		{name: "Invalid take", matrix: input, steps: []domain.PipelineStep{{Op: "qr", Take: "L"}}, expectedError: domain.ErrInvalidPipeline},
+		{
+			name:          "Step errors are propagated",
+			matrix:        input,
+			steps:         []domain.PipelineStep{{Op: "transpose"}, {Op: "cholesky"}},
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+		{name: "Empty matrix", matrix: domain.Matrix{}, steps: []domain.PipelineStep{{Op: "transpose"}}, expectedError: domain.ErrMatrixEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.RunPipeline(tt.matrix, tt.steps, false)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Nil(t, result.Steps)
+			assertMatrixInDelta(t, tt.expected, result.Result.(domain.Matrix), 1e-9)
+		})
+	}
+}
+
+func TestMatrixUsecaseRunPipelineIntermediate(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	two := 2
+	steps := []domain.PipelineStep{{Op: "transpose"}, {Op: "rotate", K: &two}, {Op: "qr", Mode: "economy"}}
+
+	result, err := uc.RunPipeline(domain.Matrix{{1, 2}, {3, 4}, {5, 6}}, steps, true)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Steps, 3)
+	assert.Equal(t, "qr", result.Steps[2].Op)
+	assertMatrixInDelta(t, domain.Matrix{{6, 4, 2}, {5, 3, 1}}, result.Steps[1].Result.(domain.Matrix), 1e-9)
+	qr, ok := result.Result.(domain.QRFactorization)
+	assert.True(t, ok)
+	assert.Equal(t, result.Steps[2].Result, result.Result)
+	assert.Len(t, qr.R, 2)
+}
@@ -0,0 +1,150 @@
+package usecase_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseDecomposePolar(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		expectedU     domain.Matrix
+		expectedP     domain.Matrix
+		expectedError error
+	}{
+		{
+			name:        "Symmetric positive definite matrix is its own P",
+			inputMatrix: domain.Matrix{{2, 1}, {1, 2}},
+			expectedU:   domain.Matrix{{1, 0}, {0, 1}},
+			expectedP:   domain.Matrix{{2, 1}, {1, 2}},
+		},
+		{
+			name:        "Scaled rotation",
+			inputMatrix: domain.Matrix{{0, -3}, {3, 0}},
+			expectedU:   domain.Matrix{{0, -1}, {1, 0}},
+			expectedP:   domain.Matrix{{3, 0}, {0, 3}},
+		},
+		{
+			name:        "General square matrix",
+			inputMatrix: domain.Matrix{{1, 2}, {3, 4}},
+		},
+		{
+			name:        "Tall matrix",
+			inputMatrix: domain.Matrix{{1, 0}, {0, 2}, {1, 1}},
+		},
+		{
+			name:          "Empty matrix",
+			inputMatrix:   domain.Matrix{},
+			expectedError: domain.ErrMatrixEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polar, err := uc.DecomposePolar(tt.inputMatrix)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.PolarDecomposition{}, polar)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.expectedU != nil {
+				assertMatrixInDelta(t, tt.expectedU, polar.U, 1e-9)
+				assertMatrixInDelta(t, tt.expectedP, polar.P, 1e-9)
+			}
+
+			cols := len(tt.inputMatrix[0])
+			assertMatrixInDelta(t, tt.inputMatrix, multiply(polar.U, polar.P), 1e-9)
+			assertMatrixInDelta(t, diagonal(ones(cols), cols, cols), multiply(transpose(polar.U), polar.U), 1e-9)
+			assertMatrixInDelta(t, polar.P, transpose(polar.P), 1e-9)
+		})
+	}
+}
+
+func TestMatrixUsecaseSolveProcrustes(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	theta := math.Pi / 6
+	rotation := domain.Matrix{{math.Cos(theta), -math.Sin(theta)}, {math.Sin(theta), math.Cos(theta)}}
+	points := domain.Matrix{{1, 0}, {0, 2}, {-1, 1}, {3, -1}}
+	reflection := domain.Matrix{{1, 0}, {0, -1}}
+
+	tests := []struct {
+		name             string
+		a                domain.Matrix
+		b                domain.Matrix
+		properRotation   bool
+		expectedRotation domain.Matrix
+		expectedResidual float64
+		expectedError    error
+	}{
+		{
+			name:             "Recovers an exact rotation",
+			a:                points,
+			b:                multiply(points, rotation),
+			expectedRotation: rotation,
+		},
+		{
+			name:             "Reflection allowed by default",
+			a:                points,
+			b:                multiply(points, reflection),
+			expectedRotation: reflection,
+		},
+		{
+			name:             "Proper rotation excludes the reflection",
+			a:                domain.Matrix{{2, 0}, {0, 1}},
+			b:                domain.Matrix{{2, 0}, {0, -1}},
+			properRotation:   true,
+			expectedRotation: domain.Matrix{{1, 0}, {0, 1}},
+			expectedResidual: 2,
+		},
+		{
+			name:          "Shapes differ",
+			a:             domain.Matrix{{1, 0}, {0, 1}},
+			b:             domain.Matrix{{1, 0, 0}, {0, 1, 0}},
+			expectedError: domain.ErrDimensionMismatch,
+		},
+		{
+			name:          "Empty matrix",
+			a:             domain.Matrix{},
+			b:             domain.Matrix{{1}},
+			expectedError: domain.ErrMatrixEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			solution, err := uc.SolveProcrustes(tt.a, tt.b, tt.properRotation)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.ProcrustesSolution{}, solution)
+				return
+			}
+
+			assert.NoError(t, err)
+			assertMatrixInDelta(t, tt.expectedRotation, solution.Rotation, 1e-9)
+			assert.InDelta(t, tt.expectedResidual, solution.Residual, 1e-9)
+		})
+	}
+}
+
+func ones(n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = 1
+	}
+	return values
+}
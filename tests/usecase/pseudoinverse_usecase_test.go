@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecasePseudoinverse(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		tolerance     float64
+		expectedPinv  domain.Matrix
+		expectedRank  int
+		expectedError error
+	}{
+		{
+			name:         "Invertible matrix equals its inverse",
+			inputMatrix:  domain.Matrix{{4, 7}, {2, 6}},
+			expectedPinv: domain.Matrix{{0.6, -0.7}, {-0.2, 0.4}},
+			expectedRank: 2,
+		},
+		{
+			name:         "Rank-deficient matrix",
+			inputMatrix:  domain.Matrix{{1, 2}, {2, 4}},
+			expectedPinv: domain.Matrix{{0.04, 0.08}, {0.08, 0.16}},
+			expectedRank: 1,
+		},
+		{
+			name:         "Tall matrix gives the left inverse",
+			inputMatrix:  domain.Matrix{{1, 0}, {0, 1}, {0, 0}},
+			expectedPinv: domain.Matrix{{1, 0, 0}, {0, 1, 0}},
+			expectedRank: 2,
+		},
+		{
+			name:         "Cutoff discards small singular values",
+			inputMatrix:  domain.Matrix{{1, 0}, {0, 1e-8}},
+			tolerance:    1e-6,
+			expectedPinv: domain.Matrix{{1, 0}, {0, 0}},
+			expectedRank: 1,
+		},
+		{
+			name:         "Zero matrix",
+			inputMatrix:  domain.Matrix{{0, 0}},
+			expectedPinv: domain.Matrix{{0}, {0}},
+			expectedRank: 0,
+		},
+		{
+			name:          "Negative tolerance",
+			inputMatrix:   domain.Matrix{{1}},
+			tolerance:     -1,
+			expectedError: domain.ErrInvalidTolerance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pinv, err := uc.Pseudoinverse(tt.inputMatrix, tt.tolerance)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.Pseudoinverse{}, pinv)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRank, pinv.Rank)
+			assert.Greater(t, pinv.Tolerance, 0.0)
+			assertMatrixInDelta(t, tt.expectedPinv, pinv.Pinv, 1e-9)
+		})
+	}
+}
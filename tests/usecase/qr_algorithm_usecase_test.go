@@ -0,0 +1,160 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseProcessMatrixQRAlgorithms(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	matrices := []struct {
+		name        string
+		inputMatrix domain.Matrix
+	}{
+		{name: "square", inputMatrix: domain.Matrix{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}}},
+		{name: "tall", inputMatrix: domain.Matrix{{1, 2}, {3, 4}, {5, 6}}},
+		{name: "wide", inputMatrix: domain.Matrix{{1, 2, 3, 4}, {5, 6, 7, 9}}},
+	}
+	algorithms := []domain.QRAlgorithm{domain.QRAlgorithmGramSchmidt, domain.QRAlgorithmGivens}
+
+	for _, m := range matrices {
+		_, reference, err := uc.ProcessMatrix(m.inputMatrix, nil, domain.QROptions{Normalize: true})
+		assert.NoError(t, err)
+
+		for _, algorithm := range algorithms {
+			t.Run(string(algorithm)+" on "+m.name+" matrix matches Householder", func(t *testing.T) {
+				_, qr, err := uc.ProcessMatrix(m.inputMatrix, nil, domain.QROptions{Algorithm: algorithm, Normalize: true})
+
+				assert.NoError(t, err)
+				assert.Nil(t, qr.Trace)
+				assertMatrixInDelta(t, reference.Q, qr.Q, 1e-9)
+				assertMatrixInDelta(t, reference.R, qr.R, 1e-9)
+			})
+
+			t.Run(string(algorithm)+" on "+m.name+" matrix in full mode", func(t *testing.T) {
+				_, qr, err := uc.ProcessMatrix(m.inputMatrix, nil, domain.QROptions{Algorithm: algorithm, Mode: domain.QRModeFull, Diagnostics: true})
+
+				assert.NoError(t, err)
+				rows := len(m.inputMatrix)
+				assert.Len(t, qr.Q, rows)
+				assert.Len(t, qr.Q[0], rows)
+				assertMatrixInDelta(t, m.inputMatrix, multiply(qr.Q, qr.R), 1e-9)
+				assertMatrixInDelta(t, diagonal(ones(rows), rows, rows), multiply(transpose(qr.Q), qr.Q), 1e-9)
+			})
+		}
+	}
+}
+
+func TestMatrixUsecaseProcessMatrixQRTrace(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	inputMatrix := domain.Matrix{{1, 2}, {3, 4}, {5, 6}}
+
+	tests := []struct {
+		name          string
+		options       domain.QROptions
+		expectedKinds []domain.QRStepKind
+		finalIsR      bool
+	}{
+		{
+			name:          "Householder reflectors",
+			options:       domain.QROptions{Algorithm: domain.QRAlgorithmHouseholder, Mode: domain.QRModeFull, Trace: true},
+			expectedKinds: []domain.QRStepKind{domain.QRStepReflector, domain.QRStepReflector},
+			finalIsR:      true,
+		},
+		{
+			name:          "Householder reflectors with column pivoting",
+			options:       domain.QROptions{Pivoting: true, Mode: domain.QRModeFull, Trace: true},
+			expectedKinds: []domain.QRStepKind{domain.QRStepReflector, domain.QRStepReflector},
+			finalIsR:      true,
+		},
+		{
+			name:          "Gram–Schmidt normalizations and projections",
+			options:       domain.QROptions{Algorithm: domain.QRAlgorithmGramSchmidt, Trace: true},
+			expectedKinds: []domain.QRStepKind{domain.QRStepNormalization, domain.QRStepProjection, domain.QRStepNormalization},
+		},
+		{
+			name:          "Givens rotations",
+			options:       domain.QROptions{Algorithm: domain.QRAlgorithmGivens, Mode: domain.QRModeFull, Trace: true},
+			expectedKinds: []domain.QRStepKind{domain.QRStepRotation, domain.QRStepRotation, domain.QRStepRotation},
+			finalIsR:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, qr, err := uc.ProcessMatrix(inputMatrix, nil, tt.options)
+
+			assert.NoError(t, err)
+			if !assert.Len(t, qr.Trace, len(tt.expectedKinds)) {
+				return
+			}
+			for i, step := range qr.Trace {
+				assert.Equal(t, tt.expectedKinds[i], step.Kind)
+				assert.NotEmpty(t, step.Description)
+				assert.Len(t, step.Matrix, len(inputMatrix))
+			}
+
+			final := qr.Trace[len(qr.Trace)-1].Matrix
+			if tt.finalIsR {
+				assertMatrixInDelta(t, qr.R, final, 1e-9)
+			} else {
+				assertMatrixInDelta(t, qr.Q, final, 1e-9)
+			}
+		})
+	}
+
+	t.Run("Gram–Schmidt coefficients are the entries of R", func(t *testing.T) {
+		_, qr, err := uc.ProcessMatrix(inputMatrix, nil, domain.QROptions{Algorithm: domain.QRAlgorithmGramSchmidt, Trace: true})
+
+		assert.NoError(t, err)
+		projection := qr.Trace[1]
+		if assert.NotNil(t, projection.Basis) && assert.NotNil(t, projection.Coefficient) {
+			assert.InDelta(t, qr.R[*projection.Basis][projection.Column], *projection.Coefficient, 1e-12)
+		}
+	})
+}
+
+func TestMatrixUsecaseProcessMatrixQRAlgorithmErrors(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		options       domain.QROptions
+		expectedError error
+	}{
+		{
+			name:          "Unknown algorithm",
+			inputMatrix:   domain.Matrix{{1, 0}, {0, 1}},
+			options:       domain.QROptions{Algorithm: "lanczos"},
+			expectedError: domain.ErrUnsupportedMode,
+		},
+		{
+			name:          "Pivoting requires Householder",
+			inputMatrix:   domain.Matrix{{1, 0}, {0, 1}},
+			options:       domain.QROptions{Algorithm: domain.QRAlgorithmGivens, Pivoting: true},
+			expectedError: domain.ErrUnsupportedMode,
+		},
+		{
+			name:          "Gram–Schmidt on dependent columns",
+			inputMatrix:   domain.Matrix{{1, 2}, {2, 4}, {3, 6}},
+			options:       domain.QROptions{Algorithm: domain.QRAlgorithmGramSchmidt},
+			expectedError: domain.ErrMatrixSingular,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, qr, err := uc.ProcessMatrix(tt.inputMatrix, nil, tt.options)
+
+			assert.Error(t, err)
+			assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+			assert.Equal(t, domain.QRFactorization{}, qr)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseApproximateLowRank(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	seed := int64(42)
+	zero := 0
+
+	// Matriz de 8×5 con valores singulares 10, 5, 1, 0.1 y 0.01.
+	decaying := diagonal([]float64{10, 5, 1, 0.1, 0.01}, 8, 5)
+	// Matriz de rango 2 exacto: suma de dos productos exteriores.
+	lowRank := multiply(
+		domain.Matrix{{1, 0}, {2, 1}, {0, 3}, {1, 1}, {4, 0}, {0, 2}},
+		domain.Matrix{{1, 2, 0, 1, 3}, {0, 1, 1, 2, 0}},
+	)
+
+	tests := []struct {
+		name           string
+		inputMatrix    domain.Matrix
+		options        domain.RandomizedSVDOptions
+		expectedSigma  []float64
+		expectedError  float64
+		spectralError  float64
+		sigmaTolerance float64
+	}{
+		{
+			name:           "Leading singular values of a decaying spectrum",
+			inputMatrix:    decaying,
+			options:        domain.RandomizedSVDOptions{Rank: 2, Seed: &seed},
+			expectedSigma:  []float64{10, 5},
+			expectedError:  math.Sqrt(1 + 0.01 + 0.0001),
+			spectralError:  1,
+			sigmaTolerance: 1e-9,
+		},
+		{
+			name:           "Exact rank is recovered without oversampling or power iterations",
+			inputMatrix:    lowRank,
+			options:        domain.RandomizedSVDOptions{Rank: 2, Oversampling: &zero, PowerIterations: &zero, Seed: &seed},
+			sigmaTolerance: 1e-9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approximation, err := uc.ApproximateLowRank(tt.inputMatrix, tt.options)
+
+			assert.NoError(t, err)
+			assert.Equal(t, seed, approximation.Seed)
+			assert.Len(t, approximation.U, len(tt.inputMatrix))
+			assert.Len(t, approximation.U[0], tt.options.Rank)
+			assert.Len(t, approximation.VT, tt.options.Rank)
+			assertMatrixInDelta(t, diagonal(ones(tt.options.Rank), tt.options.Rank, tt.options.Rank), multiply(transpose(approximation.U), approximation.U), 1e-9)
+			if tt.expectedSigma != nil {
+				assert.InDeltaSlice(t, tt.expectedSigma, approximation.Sigma, tt.sigmaTolerance)
+			}
+
+			assert.InDelta(t, tt.expectedError, approximation.FrobeniusError, 1e-9)
+			assert.GreaterOrEqual(t, approximation.SpectralErrorBound, tt.spectralError)
+			if tt.expectedError == 0 {
+				approximated := multiply(multiply(approximation.U, diagonal(approximation.Sigma, tt.options.Rank, tt.options.Rank)), approximation.VT)
+				assertMatrixInDelta(t, tt.inputMatrix, approximated, 1e-9)
+			}
+		})
+	}
+
+	t.Run("Same seed reproduces the result", func(t *testing.T) {
+		first, err := uc.ApproximateLowRank(decaying, domain.RandomizedSVDOptions{Rank: 3})
+		assert.NoError(t, err)
+
+		generatedSeed := first.Seed
+		second, err := uc.ApproximateLowRank(decaying, domain.RandomizedSVDOptions{Rank: 3, Seed: &generatedSeed})
+		assert.NoError(t, err)
+		assert.Equal(t, first, second)
+		assert.Equal(t, 10, first.Oversampling)
+		assert.Equal(t, 2, first.PowerIterations)
+	})
+
+	t.Run("Huge oversampling is clamped", func(t *testing.T) {
+		huge := math.MaxInt
+		approximation, err := uc.ApproximateLowRank(domain.Matrix{{3, 0}, {0, 1}}, domain.RandomizedSVDOptions{Rank: 1, Oversampling: &huge})
+		assert.NoError(t, err)
+		assert.Equal(t, huge, approximation.Oversampling)
+		assert.InDelta(t, 3, approximation.Sigma[0], 1e-9)
+	})
+}
+
+func TestMatrixUsecaseApproximateLowRankErrors(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	negative, tooManyIterations := -1, 21
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		options       domain.RandomizedSVDOptions
+		expectedError error
+	}{
+		{
+			name:          "Rank zero",
+			inputMatrix:   domain.Matrix{{1, 2}, {3, 4}},
+			options:       domain.RandomizedSVDOptions{Rank: 0},
+			expectedError: domain.ErrInvalidLowRankOptions,
+		},
+		{
+			name:          "Rank above min(m, n)",
+			inputMatrix:   domain.Matrix{{1, 2}, {3, 4}, {5, 6}},
+			options:       domain.RandomizedSVDOptions{Rank: 3},
+			expectedError: domain.ErrInvalidLowRankOptions,
+		},
+		{
+			name:          "Negative power iterations",
+			inputMatrix:   domain.Matrix{{1, 2}, {3, 4}},
+			options:       domain.RandomizedSVDOptions{Rank: 1, PowerIterations: &negative},
+			expectedError: domain.ErrInvalidLowRankOptions,
+		},
+		{
+			name:          "Too many power iterations",
+			inputMatrix:   domain.Matrix{{1, 2}, {3, 4}},
+			options:       domain.RandomizedSVDOptions{Rank: 1, PowerIterations: &tooManyIterations},
+			expectedError: domain.ErrInvalidLowRankOptions,
+		},
+		{
+			name:          "Empty matrix",
+			inputMatrix:   domain.Matrix{},
+			options:       domain.RandomizedSVDOptions{Rank: 1},
+			expectedError: domain.ErrMatrixEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approximation, err := uc.ApproximateLowRank(tt.inputMatrix, tt.options)
+
+			assert.Error(t, err)
+			assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+			assert.Equal(t, domain.RandomizedSVD{}, approximation)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseReduceRowEchelon(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name                 string
+		inputMatrix          domain.Matrix
+		tolerance            float64
+		expectedRREF         domain.Matrix
+		expectedPivotColumns []int
+		expectedError        error
+	}{
+		{
+			name:                 "Invertible matrix reduces to the identity",
+			inputMatrix:          domain.Matrix{{2, 1}, {1, 3}},
+			expectedRREF:         domain.Matrix{{1, 0}, {0, 1}},
+			expectedPivotColumns: []int{0, 1},
+		},
+		{
+			name:                 "Rank-deficient matrix with a free column",
+			inputMatrix:          domain.Matrix{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			expectedRREF:         domain.Matrix{{1, 0, -1}, {0, 1, 2}, {0, 0, 0}},
+			expectedPivotColumns: []int{0, 1},
+		},
+		{
+			name:                 "Augmented system",
+			inputMatrix:          domain.Matrix{{1, 1, 1, 6}, {0, 2, 5, -4}, {2, 5, -1, 27}},
+			expectedRREF:         domain.Matrix{{1, 0, 0, 5}, {0, 1, 0, 3}, {0, 0, 1, -2}},
+			expectedPivotColumns: []int{0, 1, 2},
+		},
+		{
+			name:                 "Leading zero column",
+			inputMatrix:          domain.Matrix{{0, 1, 2}, {0, 2, 4}},
+			expectedRREF:         domain.Matrix{{0, 1, 2}, {0, 0, 0}},
+			expectedPivotColumns: []int{1},
+		},
+		{
+			name:                 "Loose tolerance discards a small pivot",
+			inputMatrix:          domain.Matrix{{1, 0}, {0, 1e-8}},
+			tolerance:            1e-6,
+			expectedRREF:         domain.Matrix{{1, 0}, {0, 0}},
+			expectedPivotColumns: []int{0},
+		},
+		{
+			name:                 "Zero matrix",
+			inputMatrix:          domain.Matrix{{0, 0}, {0, 0}},
+			expectedRREF:         domain.Matrix{{0, 0}, {0, 0}},
+			expectedPivotColumns: []int{},
+		},
+		{
+			name:          "Negative tolerance",
+			inputMatrix:   domain.Matrix{{1}},
+			tolerance:     -1,
+			expectedError: domain.ErrInvalidTolerance,
+		},
+		{
+			name:          "Non-rectangular matrix",
+			inputMatrix:   domain.Matrix{{1, 2}, {3}},
+			expectedError: domain.ErrMatrixNotRectangular,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rref, err := uc.ReduceRowEchelon(tt.inputMatrix, tt.tolerance)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.ReducedRowEchelonForm{}, rref)
+				return
+			}
+
+			assert.NoError(t, err)
+			assertMatrixInDelta(t, tt.expectedRREF, rref.RREF, 1e-9)
+			assert.Equal(t, tt.expectedPivotColumns, rref.PivotColumns)
+			assert.Equal(t, len(tt.expectedPivotColumns), rref.Rank)
+			assert.NotNil(t, rref.Steps)
+			if len(rref.Steps) > 0 {
+				assert.Equal(t, rref.RREF, rref.Steps[len(rref.Steps)-1].Matrix)
+			}
+		})
+	}
+}
+
+func TestMatrixUsecaseReduceRowEchelonSteps(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	rref, err := uc.ReduceRowEchelon(domain.Matrix{{1, 2}, {4, 5}}, 0)
+
+	assert.NoError(t, err)
+	descriptions := make([]string, len(rref.Steps))
+	for i, step := range rref.Steps {
+		descriptions[i] = step.Description
+	}
+	assert.Equal(t, []string{
+		"R1 ↔ R2: intercambiar las filas 1 y 2",
+		"R1 ← R1 / 4: convertir el pivote de la columna 1 en 1",
+		"R2 ← R2 − R1: anular el elemento de la columna 1",
+		"R2 ← R2 / 0.75: convertir el pivote de la columna 2 en 1",
+		"R1 ← R1 − 1.25·R2: anular el elemento de la columna 2",
+	}, descriptions)
+
+	swap := rref.Steps[0]
+	assert.Equal(t, domain.RowOperationSwap, swap.Kind)
+	assert.Equal(t, 0, swap.Target)
+	if assert.NotNil(t, swap.Source) {
+		assert.Equal(t, 1, *swap.Source)
+	}
+	assert.Equal(t, domain.Matrix{{4, 5}, {1, 2}}, swap.Matrix)
+
+	replacement := rref.Steps[2]
+	assert.Equal(t, domain.RowOperationReplacement, replacement.Kind)
+	if assert.NotNil(t, replacement.Factor) {
+		assert.InDelta(t, -1, *replacement.Factor, 1e-12)
+	}
+	assertMatrixInDelta(t, domain.Matrix{{1, 1.25}, {0, 0.75}}, replacement.Matrix, 1e-12)
+}
+
+func TestMatrixUsecaseReduceRowEchelonRecordsDiscardedRoundOff(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	rref, err := uc.ReduceRowEchelon(domain.Matrix{{1, 2}, {3, 6 + 1e-12}}, 1e-10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, rref.PivotColumns)
+	assertMatrixInDelta(t, domain.Matrix{{1, 2}, {0, 0}}, rref.RREF, 1e-9)
+	last := rref.Steps[len(rref.Steps)-1]
+	assert.Equal(t, domain.RowOperationDiscard, last.Kind)
+	assert.Equal(t, 1, last.Target)
+	assert.Equal(t, "C2: la columna no tiene pivote; se anulan sus elementos de las filas 2 a 2, menores que la tolerancia", last.Description)
+	assert.Equal(t, rref.RREF, last.Matrix)
+}
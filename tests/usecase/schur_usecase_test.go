@@ -0,0 +1,109 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseReduceHessenberg(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		expectedError error
+	}{
+		{
+			name:        "Valid 4x4 matrix",
+			inputMatrix: domain.Matrix{{4, 1, -2, 2}, {1, 2, 0, 1}, {-2, 0, 3, -2}, {2, 1, -2, -1}},
+		},
+		{
+			name:        "Valid 1x1 matrix",
+			inputMatrix: domain.Matrix{{5}},
+		},
+		{
+			name:          "Non-square matrix",
+			inputMatrix:   domain.Matrix{{1, 2, 3}, {4, 5, 6}},
+			expectedError: domain.ErrMatrixNotSquare,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hessenberg, err := uc.ReduceHessenberg(tt.inputMatrix)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.HessenbergDecomposition{}, hessenberg)
+				return
+			}
+
+			assert.NoError(t, err)
+			for i := range hessenberg.H {
+				for j := 0; j < i-1; j++ {
+					assert.Zero(t, hessenberg.H[i][j])
+				}
+			}
+			n := len(tt.inputMatrix)
+			assertMatrixInDelta(t, diagonal([]float64{1, 1, 1, 1}, n, n), multiply(transpose(hessenberg.Q), hessenberg.Q), 1e-12)
+			assertMatrixInDelta(t, tt.inputMatrix, multiply(multiply(hessenberg.Q, hessenberg.H), transpose(hessenberg.Q)), 1e-9)
+		})
+	}
+}
+
+func TestMatrixUsecaseDecomposeSchur(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		expectedError error
+	}{
+		{
+			name:        "Real eigenvalues give an upper triangular T",
+			inputMatrix: domain.Matrix{{4, 1, 2}, {0, 3, 1}, {1, 0, 2}},
+		},
+		{
+			name:        "Complex eigenvalues give 2x2 blocks",
+			inputMatrix: domain.Matrix{{0, -2, 1}, {1, 0, 3}, {0, 0, 5}},
+		},
+		{
+			name:          "Empty matrix",
+			inputMatrix:   domain.Matrix{},
+			expectedError: domain.ErrMatrixEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schur, err := uc.DecomposeSchur(tt.inputMatrix)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.SchurDecomposition{}, schur)
+				return
+			}
+
+			assert.NoError(t, err)
+			n := len(tt.inputMatrix)
+			assert.Len(t, schur.Eigenvalues, n)
+			for i := range schur.T {
+				assert.InDelta(t, schur.T[i][i], schur.Eigenvalues[i].Real, 1e-12)
+				for j := 0; j < i-1; j++ {
+					assert.Zero(t, schur.T[i][j])
+				}
+				if i > 0 && schur.T[i][i-1] != 0 {
+					assert.NotZero(t, schur.Eigenvalues[i].Imag, "la subdiagonal solo es no nula en bloques complejos")
+				}
+			}
+			assertMatrixInDelta(t, diagonal([]float64{1, 1, 1}, n, n), multiply(transpose(schur.Z), schur.Z), 1e-12)
+			assertMatrixInDelta(t, tt.inputMatrix, multiply(multiply(schur.Z, schur.T), transpose(schur.Z)), 1e-9)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseFundamentalSubspaces(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+
+	tests := []struct {
+		name          string
+		inputMatrix   domain.Matrix
+		tolerance     float64
+		expectedRank  int
+		expectedError error
+	}{
+		{
+			name:         "Rank-deficient 3x3 matrix",
+			inputMatrix:  domain.Matrix{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			expectedRank: 2,
+		},
+		{
+			name:         "Wide full-rank matrix has a trivial left null space",
+			inputMatrix:  domain.Matrix{{1, 0, 1}, {0, 1, 1}},
+			expectedRank: 2,
+		},
+		{
+			name:         "Redundant constraints in a tall matrix",
+			inputMatrix:  domain.Matrix{{1, 1}, {2, 2}, {3, 3}, {1, 1}},
+			expectedRank: 1,
+		},
+		{
+			name:          "Negative tolerance",
+			inputMatrix:   domain.Matrix{{1}},
+			tolerance:     -1,
+			expectedError: domain.ErrInvalidTolerance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subspaces, err := uc.FundamentalSubspaces(tt.inputMatrix, tt.tolerance)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Equal(t, domain.FundamentalSubspaces{}, subspaces)
+				return
+			}
+
+			assert.NoError(t, err)
+			rows, cols := len(tt.inputMatrix), len(tt.inputMatrix[0])
+			assert.Equal(t, tt.expectedRank, subspaces.Rank)
+			assert.Len(t, subspaces.ColumnSpace, tt.expectedRank)
+			assert.Len(t, subspaces.RowSpace, tt.expectedRank)
+			assert.Len(t, subspaces.LeftNullSpace, rows-tt.expectedRank)
+			assert.Len(t, subspaces.NullSpace, cols-tt.expectedRank)
+			assert.NotNil(t, subspaces.LeftNullSpace)
+			assert.NotNil(t, subspaces.NullSpace)
+
+			// A·x = 0 para cada x del espacio nulo y yᵀ·A = 0 para cada y del nulo izquierdo.
+			for _, x := range subspaces.NullSpace {
+				product := multiply(tt.inputMatrix, transpose(domain.Matrix{x}))
+				assertMatrixInDelta(t, diagonal(nil, rows, 1), product, 1e-9)
+			}
+			for _, y := range subspaces.LeftNullSpace {
+				product := multiply(domain.Matrix{y}, tt.inputMatrix)
+				assertMatrixInDelta(t, diagonal(nil, 1, cols), product, 1e-9)
+			}
+
+			// Las bases de Rⁿ (fila + nula) y Rᵐ (columna + nula izquierda) son ortonormales.
+			rowBasis := append(append(domain.Matrix{}, subspaces.RowSpace...), subspaces.NullSpace...)
+			columnBasis := append(append(domain.Matrix{}, subspaces.ColumnSpace...), subspaces.LeftNullSpace...)
+			ones := []float64{1, 1, 1, 1}
+			assertMatrixInDelta(t, diagonal(ones, cols, cols), multiply(rowBasis, transpose(rowBasis)), 1e-9)
+			assertMatrixInDelta(t, diagonal(ones, rows, rows), multiply(columnBasis, transpose(columnBasis)), 1e-9)
+		})
+	}
+}
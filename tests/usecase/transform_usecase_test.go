@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"api-go/internal/domain"
+	"api-go/internal/usecase"
+)
+
+func TestMatrixUsecaseProcessMatrixTransforms(t *testing.T) {
+	uc := usecase.NewMatrixUsecase()
+	input := domain.Matrix{{1, 2, 3}, {4, 5, 6}}
+
+	tests := []struct {
+		name          string
+		transforms    []domain.MatrixTransform
+		expected      domain.Matrix
+		expectedError error
+	}{
+		{name: "Default rotates 90 degrees clockwise", expected: domain.Matrix{{4, 1}, {5, 2}, {6, 3}}},
+		{name: "Identity", transforms: []domain.MatrixTransform{domain.TransformIdentity}, expected: input},
+		{name: "Rotate 180 degrees", transforms: []domain.MatrixTransform{domain.TransformRotateCW180}, expected: domain.Matrix{{6, 5, 4}, {3, 2, 1}}},
+		{name: "Rotate 270 degrees clockwise", transforms: []domain.MatrixTransform{domain.TransformRotateCW270}, expected: domain.Matrix{{3, 6}, {2, 5}, {1, 4}}},
+		{name: "Rotate 90 degrees counter-clockwise", transforms: []domain.MatrixTransform{domain.TransformRotateCCW90}, expected: domain.Matrix{{3, 6}, {2, 5}, {1, 4}}},
+		{name: "Flip horizontal", transforms: []domain.MatrixTransform{domain.TransformFlipHorizontal}, expected: domain.Matrix{{3, 2, 1}, {6, 5, 4}}},
+		{name: "Flip vertical", transforms: []domain.MatrixTransform{domain.TransformFlipVertical}, expected: domain.Matrix{{4, 5, 6}, {1, 2, 3}}},
+		{name: "Transpose", transforms: []domain.MatrixTransform{domain.TransformTranspose}, expected: domain.Matrix{{1, 4}, {2, 5}, {3, 6}}},
+		{name: "Anti-transpose", transforms: []domain.MatrixTransform{domain.TransformAntiTranspose}, expected: domain.Matrix{{6, 3}, {5, 2}, {4, 1}}},
+		{
+			name:       "Three clockwise quarter turns",
+			transforms: []domain.MatrixTransform{domain.TransformRotateCW90, domain.TransformRotateCW90, domain.TransformRotateCW90},
+			expected:   domain.Matrix{{3, 6}, {2, 5}, {1, 4}},
+		},
+		{
+			name:       "Transpose then flip vertical",
+			transforms: []domain.MatrixTransform{domain.TransformTranspose, domain.TransformFlipVertical},
+			expected:   domain.Matrix{{3, 6}, {2, 5}, {1, 4}},
+		},
+		{
+			name:       "Double flip cancels out",
+			transforms: []domain.MatrixTransform{domain.TransformFlipHorizontal, domain.TransformFlipHorizontal},
+			expected:   input,
+		},
+		{
+			name:          "Unknown transform",
+			transforms:    []domain.MatrixTransform{domain.TransformTranspose, "rotate-45"},
+			expectedError: domain.ErrUnsupportedTransform,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transformed, _, err := uc.ProcessMatrix(input, tt.transforms, domain.QROptions{})
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				assert.Nil(t, transformed)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, transformed)
+		})
+	}
+
+	t.Run("Composition matches applying each transform in turn", func(t *testing.T) {
+		all := []domain.MatrixTransform{
+			domain.TransformIdentity, domain.TransformRotateCW90, domain.TransformRotateCW180, domain.TransformRotateCW270,
+			domain.TransformRotateCCW90, domain.TransformRotateCCW180, domain.TransformRotateCCW270,
+			domain.TransformFlipHorizontal, domain.TransformFlipVertical, domain.TransformTranspose, domain.TransformAntiTranspose,
+		}
+		for _, first := range all {
+			for _, second := range all {
+				intermediate, _, err := uc.ProcessMatrix(input, []domain.MatrixTransform{first}, domain.QROptions{})
+				assert.NoError(t, err)
+				expected, _, err := uc.ProcessMatrix(intermediate, []domain.MatrixTransform{second}, domain.QROptions{})
+				assert.NoError(t, err)
+
+				composed, _, err := uc.ProcessMatrix(input, []domain.MatrixTransform{first, second}, domain.QROptions{})
+				assert.NoError(t, err)
+				assert.Equal(t, expected, composed, "%s then %s", first, second)
+			}
+		}
+	})
+}
+
+func TestMatrixTransformsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		expected      domain.MatrixTransforms
+		expectedError error
+	}{
+		{name: "Omitted", body: `{"matrix": [[1]]}`},
+		{name: "Null is the same as omitted", body: `{"matrix": [[1]], "transform": null}`},
+		{name: "Single transform", body: `{"matrix": [[1]], "transform": "transpose"}`, expected: domain.MatrixTransforms{domain.TransformTranspose}},
+		{
+			name:     "List of transforms",
+			body:     `{"matrix": [[1]], "transform": ["transpose", "flip-vertical"]}`,
+			expected: domain.MatrixTransforms{domain.TransformTranspose, domain.TransformFlipVertical},
+		},
+		{name: "Empty list", body: `{"matrix": [[1]], "transform": []}`, expectedError: domain.ErrUnsupportedTransform},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req domain.MatrixRequest
+			err := json.Unmarshal([]byte(tt.body), &req)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.expectedError), "Expected error %v, got %v", tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, req.Transform)
+		})
+	}
+
+	t.Run("Null rotates 90 degrees clockwise", func(t *testing.T) {
+		var req domain.MatrixRequest
+		assert.NoError(t, json.Unmarshal([]byte(`{"matrix": [[1, 2], [3, 4]], "transform": null}`), &req))
+
+		rotated, _, err := usecase.NewMatrixUsecase().ProcessMatrix(req.Matrix, req.Transform, domain.QROptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Matrix{{3, 1}, {4, 2}}, rotated)
+	})
+}